@@ -0,0 +1,87 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package arrowdate converts between slices of epochdate.Date and Apache
+// Arrow Date32 arrays, as implemented by github.com/apache/arrow-go. Since
+// Date32 values are also days since the Unix epoch, conversion is a copy;
+// only Date32 values outside of the representable range of Date are
+// rejected.
+package arrowdate
+
+import (
+	"errors"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/extemporalgenome/epochdate"
+)
+
+// ErrNull is returned by Dates for arrays containing nulls.
+var ErrNull = errors.New("arrowdate: array contains nulls; use NullDates")
+
+// Append appends dates to b.
+func Append(b *array.Date32Builder, dates ...epochdate.Date) {
+	b.Reserve(len(dates))
+	for _, d := range dates {
+		b.Append(arrow.Date32(d))
+	}
+}
+
+// AppendNull appends dates to b, appending nulls for invalid dates.
+func AppendNull(b *array.Date32Builder, dates ...epochdate.NullDate) {
+	b.Reserve(len(dates))
+	for _, d := range dates {
+		if d.Valid {
+			b.Append(arrow.Date32(d.Date))
+		} else {
+			b.AppendNull()
+		}
+	}
+}
+
+// NewArray returns a new Date32 array, allocated from mem, holding dates.
+// The caller is responsible for releasing the array.
+func NewArray(mem memory.Allocator, dates []epochdate.Date) *array.Date32 {
+	b := array.NewDate32Builder(mem)
+	defer b.Release()
+	Append(b, dates...)
+	return b.NewDate32Array()
+}
+
+// Dates returns the values of a. ErrNull is returned if a contains nulls, and
+// an error wrapping epochdate.ErrOutOfRange if it contains values outside of
+// the representable range of Date.
+func Dates(a *array.Date32) ([]epochdate.Date, error) {
+	if a.NullN() > 0 {
+		return nil, ErrNull
+	}
+	dates := make([]epochdate.Date, a.Len())
+	for i, v := range a.Date32Values() {
+		d, err := epochdate.FromEpochDay(int64(v))
+		if err != nil {
+			return nil, err
+		}
+		dates[i] = d
+	}
+	return dates, nil
+}
+
+// NullDates returns the values of a, with nulls represented by invalid
+// NullDates. An error wrapping epochdate.ErrOutOfRange is returned if a
+// contains non-null values outside of the representable range of Date.
+func NullDates(a *array.Date32) ([]epochdate.NullDate, error) {
+	dates := make([]epochdate.NullDate, a.Len())
+	for i, v := range a.Date32Values() {
+		if a.IsNull(i) {
+			continue
+		}
+		d, err := epochdate.FromEpochDay(int64(v))
+		if err != nil {
+			return nil, err
+		}
+		dates[i] = epochdate.NullDate{Date: d, Valid: true}
+	}
+	return dates, nil
+}
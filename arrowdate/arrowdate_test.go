@@ -0,0 +1,79 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arrowdate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/extemporalgenome/epochdate"
+)
+
+func TestRoundTrip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	in := []epochdate.Date{0, 15409, ^epochdate.Date(0)}
+	a := NewArray(mem, in)
+	defer a.Release()
+	if a.Len() != len(in) {
+		t.Fatalf("Expected NewArray to return %d values but got %d", len(in), a.Len())
+	}
+	if v := a.Value(1); v != arrow.Date32(15409) || v.ToTime().Format(epochdate.RFC3339) != "2012-03-10" {
+		t.Errorf("Expected Value(1) to return 2012-03-10 but got %v", v.ToTime())
+	}
+	out, err := Dates(a)
+	if err != nil {
+		t.Fatal("Unexpected Dates error:", err)
+	}
+	for i := range in {
+		if out[i] != in[i] {
+			t.Errorf("Expected Dates()[%d] to return %s but got %s", i, in[i], out[i])
+		}
+	}
+}
+
+func TestNulls(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	in := []epochdate.NullDate{{Date: 1, Valid: true}, {}, {Date: 0, Valid: true}}
+	b := array.NewDate32Builder(mem)
+	defer b.Release()
+	AppendNull(b, in...)
+	a := b.NewDate32Array()
+	defer a.Release()
+	if _, err := Dates(a); err != ErrNull {
+		t.Errorf("Expected Dates to return %v but got %v", ErrNull, err)
+	}
+	out, err := NullDates(a)
+	if err != nil {
+		t.Fatal("Unexpected NullDates error:", err)
+	}
+	for i := range in {
+		if out[i] != in[i] {
+			t.Errorf("Expected NullDates()[%d] to return %v but got %v", i, in[i], out[i])
+		}
+	}
+}
+
+func TestOutOfRange(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	for _, v := range []arrow.Date32{-1, 1 << 16} {
+		b := array.NewDate32Builder(mem)
+		b.Append(v)
+		a := b.NewDate32Array()
+		b.Release()
+		if _, err := Dates(a); !errors.Is(err, epochdate.ErrOutOfRange) {
+			t.Errorf("Expected Dates(%d) to return %v but got %v", v, epochdate.ErrOutOfRange, err)
+		}
+		if _, err := NullDates(a); !errors.Is(err, epochdate.ErrOutOfRange) {
+			t.Errorf("Expected NullDates(%d) to return %v but got %v", v, epochdate.ErrOutOfRange, err)
+		}
+		a.Release()
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+)
+
+// AvroSchema is the Avro schema of the date logical type: an int holding the
+// number of days since the Unix epoch.
+const AvroSchema = `{"type":"int","logicalType":"date"}`
+
+var errAvro = errors.New("epochdate: malformed Avro int")
+
+// AppendAvro appends the Avro binary encoding of d, as the date logical type,
+// to b. The encoding is a zig-zag varint, occupying at most 3 bytes.
+func (d Date) AppendAvro(b []byte) []byte {
+	return binary.AppendVarint(b, int64(d))
+}
+
+// DecodeAvro decodes an Avro date from the beginning of b, returning the
+// Date and the number of bytes read. An error wrapping ErrOutOfRange is
+// returned for days outside of Date's representable range.
+func DecodeAvro(b []byte) (Date, int, error) {
+	v, n := binary.Varint(b)
+	if n <= 0 || v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, 0, errAvro
+	}
+	d, err := FromEpochDay(v)
+	if err != nil {
+		return 0, 0, err
+	}
+	return d, n, nil
+}
+
+// PatchAvroSchema returns a copy of the JSON Avro schema with the named
+// record fields, at any depth, annotated as the date logical type. This is
+// intended for schemas generated from Go types, in which Date fields are
+// described as plain ints. Fields whose type is an int, or a union
+// containing an int (such as ["null","int"] for optional fields), are
+// patched; it is an error for a named field to have another type. Object keys
+// in the returned schema are sorted.
+func PatchAvroSchema(schema []byte, fields ...string) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(schema))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(fields))
+	for _, name := range fields {
+		names[name] = true
+	}
+	if err := patchAvro(v, names); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// patchAvro patches the fields of any records within the decoded schema v.
+func patchAvro(v interface{}, names map[string]bool) error {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, elem := range v {
+			if err := patchAvro(elem, names); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		if fields, ok := v["fields"].([]interface{}); ok && v["type"] == "record" {
+			for _, f := range fields {
+				f, ok := f.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if name, _ := f["name"].(string); names[name] {
+					t, ok := patchedAvroType(f["type"])
+					if !ok {
+						return errors.New("epochdate: Avro field " + name + " is not an int")
+					}
+					f["type"] = t
+				}
+			}
+		}
+		for k, elem := range v {
+			if k != "name" {
+				if err := patchAvro(elem, names); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// patchedAvroType returns the date logical type in place of the int type t,
+// or of the int branch of the union t.
+func patchedAvroType(t interface{}) (interface{}, bool) {
+	date := map[string]interface{}{"type": "int", "logicalType": "date"}
+	switch t := t.(type) {
+	case string:
+		return date, t == "int"
+	case map[string]interface{}:
+		return date, t["type"] == "int"
+	case []interface{}:
+		for i, branch := range t {
+			if b, ok := patchedAvroType(branch); ok {
+				union := append([]interface{}(nil), t...)
+				union[i] = b
+				return union, true
+			}
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,85 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAvro(t *testing.T) {
+	tests := []struct {
+		d Date
+		b string
+	}{
+		{0, "\x00"},
+		{1, "\x02"},
+		{63, "\x7e"},
+		{64, "\x80\x01"},
+		{15409, "\xe2\xf0\x01"},
+		{^Date(0), "\xfe\xff\x07"},
+	}
+	for _, test := range tests {
+		b := test.d.AppendAvro([]byte("x"))
+		if string(b) != "x"+test.b {
+			t.Errorf("Expected Date(%d).AppendAvro() to return %x but got %x", test.d, test.b, b[1:])
+		}
+		d, n, err := DecodeAvro([]byte(test.b + "rest"))
+		if err != nil || d != test.d || n != len(test.b) {
+			t.Errorf("Expected DecodeAvro(%x) to return %d, %d but got %d, %d, %v", test.b, test.d, len(test.b), d, n, err)
+		}
+	}
+}
+
+func TestDecodeAvroErrors(t *testing.T) {
+	tests := []struct {
+		b     string
+		error error
+	}{
+		{"", errAvro},
+		{"\x80", errAvro},
+		{"\xfe\xff\xff\xff\x1f", errAvro},
+		{"\x01", ErrOutOfRange},
+		{"\x80\x80\x08", ErrOutOfRange},
+	}
+	for _, test := range tests {
+		if _, _, err := DecodeAvro([]byte(test.b)); !errors.Is(err, test.error) {
+			t.Errorf("Expected DecodeAvro(%x) to return %v but got %v", test.b, test.error, err)
+		}
+	}
+}
+
+func TestPatchAvroSchema(t *testing.T) {
+	const schema = `{
+		"type": "record", "name": "Event",
+		"fields": [
+			{"name": "id", "type": "long"},
+			{"name": "day", "type": "int"},
+			{"name": "until", "type": ["null", "int"], "default": null},
+			{"name": "meta", "type": {"type": "record", "name": "Meta", "fields": [
+				{"name": "day", "type": {"type": "int"}},
+				{"name": "count", "type": "int"}
+			]}}
+		]
+	}`
+	const want = `{"fields":[` +
+		`{"name":"id","type":"long"},` +
+		`{"name":"day","type":{"logicalType":"date","type":"int"}},` +
+		`{"default":null,"name":"until","type":["null",{"logicalType":"date","type":"int"}]},` +
+		`{"name":"meta","type":{"fields":[` +
+		`{"name":"day","type":{"logicalType":"date","type":"int"}},` +
+		`{"name":"count","type":"int"}],"name":"Meta","type":"record"}}],` +
+		`"name":"Event","type":"record"}`
+	b, err := PatchAvroSchema([]byte(schema), "day", "until")
+	if err != nil || string(b) != want {
+		t.Errorf("Expected PatchAvroSchema to return\n%s\nbut got\n%s, %v", want, b, err)
+	}
+	if _, err := PatchAvroSchema([]byte(schema), "id"); err == nil {
+		t.Error("Expected PatchAvroSchema of a long field to return an error")
+	}
+	if _, err := PatchAvroSchema([]byte(`{`), "day"); err == nil {
+		t.Error("Expected PatchAvroSchema of malformed JSON to return an error")
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import "sync"
+
+// A FormatCache memoizes the results of Date.Format, keyed by date and
+// layout. Implementations must be safe for concurrent use.
+type FormatCache interface {
+	Load(d Date, layout string) (s string, ok bool)
+	Store(d Date, layout string, s string)
+}
+
+// MapCache is a FormatCache backed by a sync.Map. It is well suited to
+// formatting a small, stable set of dates and layouts many times; since
+// entries are never evicted, its size grows with the number of distinct
+// (date, layout) pairs formatted. The zero value is an empty cache ready for
+// use.
+type MapCache struct {
+	m sync.Map
+}
+
+type formatKey struct {
+	date   Date
+	layout string
+}
+
+// Load implements FormatCache.
+func (c *MapCache) Load(d Date, layout string) (string, bool) {
+	s, ok := c.m.Load(formatKey{d, layout})
+	if !ok {
+		return "", false
+	}
+	return s.(string), true
+}
+
+// Store implements FormatCache.
+func (c *MapCache) Store(d Date, layout string, s string) {
+	c.m.Store(formatKey{d, layout}, s)
+}
+
+// FormatCached is like Format, but consults c for a previously formatted
+// result before formatting d, and stores newly formatted results in c.
+func (d Date) FormatCached(c FormatCache, layout string) string {
+	if s, ok := c.Load(d, layout); ok {
+		return s
+	}
+	s := d.Format(layout)
+	c.Store(d, layout, s)
+	return s
+}
@@ -0,0 +1,50 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import "testing"
+
+var _ FormatCache = new(MapCache)
+
+type countingCache struct {
+	MapCache
+	stores int
+}
+
+func (c *countingCache) Store(d Date, layout string, s string) {
+	c.stores++
+	c.MapCache.Store(d, layout, s)
+}
+
+func TestFormatCached(t *testing.T) {
+	var c countingCache
+	d := MustParse(RFC3339, "2012-03-10")
+	for i := 0; i < 3; i++ {
+		for _, layout := range []string{RFC3339, "Jan 2, 2006"} {
+			if s, want := d.FormatCached(&c, layout), d.Format(layout); s != want {
+				t.Errorf("Expected FormatCached(%q) to return %q but got %q", layout, want, s)
+			}
+		}
+		if s := (d + 1).FormatCached(&c, RFC3339); s != "2012-03-11" {
+			t.Error("Expected FormatCached to distinguish dates but got", s)
+		}
+	}
+	if c.stores != 3 {
+		t.Error("Expected 3 cache stores but got", c.stores)
+	}
+}
+
+func BenchmarkFormatCached(b *testing.B) {
+	var c MapCache
+	for i := 0; i < b.N; i++ {
+		benchString = benchDate.FormatCached(&c, "Monday, January 2 2006")
+	}
+}
+
+func BenchmarkFormatUncached(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchString = benchDate.Format("Monday, January 2 2006")
+	}
+}
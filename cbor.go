@@ -0,0 +1,166 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// CBOR tags for dates, from RFC 8949 and RFC 8943.
+const (
+	cborTagDateTime  = 0    // RFC3339 date/time string
+	cborTagEpochTime = 1    // seconds since the Unix epoch
+	cborTagDays      = 100  // days since the Unix epoch
+	cborTagFullDate  = 1004 // RFC3339 full-date string
+)
+
+// CBOR major types.
+const (
+	cborUint   = 0
+	cborNegInt = 1
+	cborText   = 3
+	cborTag    = 6
+	cborSimple = 7
+)
+
+var errCBOR = errors.New("epochdate: malformed CBOR date")
+
+// MarshalCBOR encodes d as a CBOR data item: the day number wrapped in tag
+// 100, as described in RFC 8943. The method signature is that used by
+// github.com/fxamacker/cbor.
+func (d Date) MarshalCBOR() ([]byte, error) {
+	b := make([]byte, 0, 5)
+	b = appendCBORHead(b, cborTag, cborTagDays)
+	return appendCBORHead(b, cborUint, uint64(d)), nil
+}
+
+// UnmarshalCBOR decodes a single CBOR data item into d. Tag 100 (days since
+// the epoch) and tag 1004 (full-date string) are accepted, as are tag 0
+// (RFC3339 date/time string) and tag 1 (seconds since the epoch), for which
+// time-of-day information is discarded as with NewFromTime and NewFromUnix.
+// Decoding a CBOR null leaves d unchanged.
+func (d *Date) UnmarshalCBOR(data []byte) error {
+	if len(data) == 1 && data[0] == cborSimple<<5|22 {
+		return nil
+	}
+	major, tag, data, ok := cborHead(data)
+	if !ok || major != cborTag {
+		return errCBOR
+	}
+	major, arg, data, ok := cborHead(data)
+	if !ok {
+		return errCBOR
+	}
+	var (
+		date Date
+		err  error
+	)
+	switch tag {
+	case cborTagDays, cborTagEpochTime:
+		var n int64
+		switch {
+		case major == cborUint && arg <= math.MaxInt64:
+			n = int64(arg)
+		case major == cborNegInt && arg <= math.MaxInt64:
+			n = -1 - int64(arg)
+		case major == cborSimple && tag == cborTagEpochTime:
+			var f float64
+			if f, data, ok = cborFloat(arg, data); !ok || math.IsNaN(f) || math.Abs(f) >= math.MaxInt64 {
+				return errCBOR
+			}
+			n = int64(math.Floor(f))
+		default:
+			return errCBOR
+		}
+		if len(data) != 0 {
+			return errCBOR
+		}
+		if tag == cborTagDays {
+			date, err = FromEpochDay(n)
+		} else {
+			date, err = NewFromUnix(n)
+		}
+	case cborTagFullDate, cborTagDateTime:
+		if major != cborText || uint64(len(data)) != arg {
+			return errCBOR
+		}
+		if tag == cborTagFullDate {
+			date, err = ParseDateOnly(string(data))
+		} else {
+			var t time.Time
+			if t, err = time.Parse(time.RFC3339, string(data)); err == nil {
+				date, err = NewFromTime(t)
+			}
+		}
+	default:
+		return fmt.Errorf("epochdate: unsupported CBOR tag %d", tag)
+	}
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
+}
+
+// appendCBORHead appends the initial bytes of a data item with the given
+// major type and argument, using the shortest encoding.
+func appendCBORHead(b []byte, major byte, arg uint64) []byte {
+	major <<= 5
+	switch {
+	case arg < 24:
+		return append(b, major|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(b, major|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, major|25), uint16(arg))
+	case arg <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, major|26), uint32(arg))
+	}
+	return binary.BigEndian.AppendUint64(append(b, major|27), arg)
+}
+
+// cborHead decodes the initial bytes of a data item, returning its major
+// type, argument, and the remaining data. For major type 7 with a float
+// encoding, arg is the additional information (25, 26, or 27) and the
+// payload is left in rest. Indefinite lengths are not supported.
+func cborHead(b []byte) (major byte, arg uint64, rest []byte, ok bool) {
+	if len(b) == 0 {
+		return 0, 0, nil, false
+	}
+	major, info := b[0]>>5, uint64(b[0]&31)
+	b = b[1:]
+	if major == cborSimple && info >= 25 && info <= 27 {
+		return major, info, b, true
+	}
+	switch {
+	case info < 24:
+		return major, info, b, true
+	case info == 24 && len(b) >= 1:
+		return major, uint64(b[0]), b[1:], true
+	case info == 25 && len(b) >= 2:
+		return major, uint64(binary.BigEndian.Uint16(b)), b[2:], true
+	case info == 26 && len(b) >= 4:
+		return major, uint64(binary.BigEndian.Uint32(b)), b[4:], true
+	case info == 27 && len(b) >= 8:
+		return major, binary.BigEndian.Uint64(b), b[8:], true
+	}
+	return 0, 0, nil, false
+}
+
+// cborFloat decodes a single or double precision float payload following an
+// initial byte with the given additional information.
+func cborFloat(info uint64, b []byte) (f float64, rest []byte, ok bool) {
+	switch {
+	case info == 26 && len(b) >= 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), b[4:], true
+	case info == 27 && len(b) >= 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), b[8:], true
+	}
+	return 0, nil, false
+}
@@ -0,0 +1,94 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMarshalCBOR(t *testing.T) {
+	tests := []struct {
+		d Date
+		b string
+	}{
+		{0, "\xd8\x64\x00"},
+		{23, "\xd8\x64\x17"},
+		{24, "\xd8\x64\x18\x18"},
+		{255, "\xd8\x64\x18\xff"},
+		{15409, "\xd8\x64\x19\x3c\x31"},
+		{^Date(0), "\xd8\x64\x19\xff\xff"},
+	}
+	for _, test := range tests {
+		b, err := test.d.MarshalCBOR()
+		if err != nil || string(b) != test.b {
+			t.Errorf("Expected Date(%d).MarshalCBOR() to return %x but got %x, %v", test.d, test.b, b, err)
+		}
+		var d Date
+		if err := d.UnmarshalCBOR(b); err != nil || d != test.d {
+			t.Errorf("Expected UnmarshalCBOR(%x) to return %d but got %d, %v", b, test.d, d, err)
+		}
+	}
+}
+
+func TestUnmarshalCBOR(t *testing.T) {
+	const want = Date(15409) // 2012-03-10
+	tests := []struct {
+		name string
+		b    string
+	}{
+		{"tag 100 uint64", "\xd8\x64\x1b\x00\x00\x00\x00\x00\x00\x3c\x31"},
+		{"tag 1004", "\xd9\x03\xec\x6a2012-03-10"},
+		{"tag 0", "\xc0\x742012-03-10T23:30:00Z"},
+		{"tag 0 offset", "\xc0\x78\x192012-03-10T01:00:00+05:00"},
+		{"tag 1 uint", "\xc1\x1a\x4f\x5a\xa8\x00"},
+		{"tag 1 float64", "\xc1\xfb\x41\xd3\xd6\xaa\x00\x00\x00\x00"},
+	}
+	for _, test := range tests {
+		var d Date
+		if err := d.UnmarshalCBOR([]byte(test.b)); err != nil || d != want {
+			t.Errorf("Expected UnmarshalCBOR(%s) to return %s but got %s, %v", test.name, want, d, err)
+		}
+	}
+	d := want
+	if err := d.UnmarshalCBOR([]byte{0xf6}); err != nil || d != want {
+		t.Errorf("Expected UnmarshalCBOR(null) to leave %s unchanged but got %s, %v", want, d, err)
+	}
+}
+
+func TestUnmarshalCBORErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		b     string
+		error error
+	}{
+		{"empty", "", errCBOR},
+		{"untagged", "\x19\x3c\x31", errCBOR},
+		{"truncated tag", "\xd9\x03", errCBOR},
+		{"truncated value", "\xd8\x64\x19\x3c", errCBOR},
+		{"trailing data", "\xd8\x64\x00\x00", errCBOR},
+		{"text in tag 100", "\xd8\x64\x6a2012-03-10", errCBOR},
+		{"short text", "\xd9\x03\xec\x6a2012-03-1", errCBOR},
+		{"half float", "\xc1\xf9\x00\x00", errCBOR},
+		{"negative", "\xd8\x64\x20", ErrOutOfRange},
+		{"too late", "\xd8\x64\x1a\x00\x01\x00\x00", ErrOutOfRange},
+	}
+	for _, test := range tests {
+		d := Date(7)
+		err := d.UnmarshalCBOR([]byte(test.b))
+		if !errors.Is(err, test.error) {
+			t.Errorf("Expected UnmarshalCBOR(%s) to return %v but got %v", test.name, test.error, err)
+		}
+		if d != 7 {
+			t.Errorf("Expected failed UnmarshalCBOR(%s) to leave the date unchanged but got %d", test.name, d)
+		}
+	}
+	for _, b := range []string{"\xd8\x65\x00", "\xd9\x03\xec\x6a2012-02-30"} {
+		var d Date
+		if err := d.UnmarshalCBOR([]byte(b)); err == nil {
+			t.Errorf("Expected UnmarshalCBOR(%x) to return an error", b)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+)
+
+// ErrColumnFormat is returned when decoding malformed NullableColumn binary
+// data.
+var ErrColumnFormat = errors.New("epochdate: malformed column encoding")
+
+// NullableColumn is a sequence of optional dates, stored compactly as a
+// []Date with a separate validity bitmap, rather than as a []*Date. The zero
+// value is an empty column ready for use.
+type NullableColumn struct {
+	dates []Date
+	valid []byte // bit i%8 of valid[i/8] is set if dates[i] is valid
+}
+
+// Len returns the number of entries, valid or not, in the column.
+func (c *NullableColumn) Len() int {
+	return len(c.dates)
+}
+
+// Append adds a valid date to the end of the column.
+func (c *NullableColumn) Append(d Date) {
+	c.grow()
+	c.Set(len(c.dates)-1, d)
+}
+
+// AppendNull adds an invalid (null) entry to the end of the column.
+func (c *NullableColumn) AppendNull() {
+	c.grow()
+}
+
+func (c *NullableColumn) grow() {
+	if len(c.dates)%8 == 0 {
+		c.valid = append(c.valid, 0)
+	}
+	c.dates = append(c.dates, 0)
+}
+
+// Get returns the date at index i and whether it is valid. The date of an
+// invalid entry is always zero. Get panics if i is out of range.
+func (c *NullableColumn) Get(i int) (d Date, valid bool) {
+	return c.dates[i], c.valid[i/8]&(1<<uint(i%8)) != 0
+}
+
+// Set stores a valid date at index i. Set panics if i is out of range.
+func (c *NullableColumn) Set(i int, d Date) {
+	c.dates[i] = d
+	c.valid[i/8] |= 1 << uint(i%8)
+}
+
+// SetNull marks the entry at index i as invalid. SetNull panics if i is out of
+// range.
+func (c *NullableColumn) SetNull(i int) {
+	c.dates[i] = 0
+	c.valid[i/8] &^= 1 << uint(i%8)
+}
+
+// Range calls fn for each entry in the column, in order. If fn returns false,
+// Range stops the iteration.
+func (c *NullableColumn) Range(fn func(i int, d Date, valid bool) bool) {
+	for i := range c.dates {
+		d, valid := c.Get(i)
+		if !fn(i, d, valid) {
+			return
+		}
+	}
+}
+
+// MarshalJSON implements json.Marshaler, encoding the column as an array of
+// date strings formatted using TextLayout, with null for invalid entries.
+func (c NullableColumn) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, 2+len(c.dates)*(len(RFC3339)+3))
+	b = append(b, '[')
+	c.Range(func(i int, d Date, valid bool) bool {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		if valid {
+			b = append(d.AppendFormat(append(b, '"'), TextLayout), '"')
+		} else {
+			b = append(b, jsonNull...)
+		}
+		return true
+	})
+	return append(b, ']'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the contents of the
+// column with those of a JSON array of date strings, as parsed by
+// Date.UnmarshalJSON, and nulls.
+func (c *NullableColumn) UnmarshalJSON(data []byte) error {
+	var values []json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	var col NullableColumn
+	for _, v := range values {
+		if bytes.Equal(v, jsonNull) {
+			col.AppendNull()
+			continue
+		}
+		var d Date
+		if err := d.UnmarshalJSON(v); err != nil {
+			return err
+		}
+		col.Append(d)
+	}
+	*c = col
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding is a
+// 4-byte big-endian entry count, followed by the validity bitmap (one bit per
+// entry, least significant bit first), followed by each entry as a 2-byte
+// big-endian day number.
+func (c NullableColumn) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 4, 4+len(c.valid)+2*len(c.dates))
+	binary.BigEndian.PutUint32(b, uint32(len(c.dates)))
+	b = append(b, c.valid...)
+	for _, d := range c.dates {
+		b = append(b, byte(d>>8), byte(d))
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding the format
+// produced by MarshalBinary. ErrColumnFormat is returned for malformed data.
+func (c *NullableColumn) UnmarshalBinary(data []byte) error {
+	if len(data) < 4 {
+		return ErrColumnFormat
+	}
+	n := binary.BigEndian.Uint32(data)
+	data = data[4:]
+	nvalid := (uint64(n) + 7) / 8
+	if uint64(len(data)) != nvalid+2*uint64(n) {
+		return ErrColumnFormat
+	}
+	col := NullableColumn{
+		dates: make([]Date, n),
+		valid: append([]byte(nil), data[:nvalid]...),
+	}
+	if n%8 != 0 {
+		// Clear the padding bits, which later appends assume are unset.
+		col.valid[nvalid-1] &= 1<<(n%8) - 1
+	}
+	data = data[nvalid:]
+	for i := range col.dates {
+		if _, valid := col.Get(i); valid {
+			col.dates[i] = Date(binary.BigEndian.Uint16(data[2*i:]))
+		}
+	}
+	*c = col
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = new(NullableColumn)
+	_ encoding.BinaryUnmarshaler = new(NullableColumn)
+	_ json.Marshaler             = new(NullableColumn)
+	_ json.Unmarshaler           = new(NullableColumn)
+)
+
+func newTestColumn() *NullableColumn {
+	c := new(NullableColumn)
+	for i := 0; i < 10; i++ {
+		if i%3 == 0 {
+			c.AppendNull()
+		} else {
+			c.Append(Date(i))
+		}
+	}
+	return c
+}
+
+func TestNullableColumn(t *testing.T) {
+	c := newTestColumn()
+	if c.Len() != 10 {
+		t.Fatal("Expected column length of 10 but got", c.Len())
+	}
+	c.Range(func(i int, d Date, valid bool) bool {
+		if valid != (i%3 != 0) {
+			t.Errorf("Expected entry %d validity to be %t", i, i%3 != 0)
+		} else if valid && d != Date(i) {
+			t.Errorf("Expected entry %d to be Date(%d) but got %d", i, i, d)
+		}
+		return true
+	})
+	c.SetNull(1)
+	c.Set(3, 42)
+	if d, valid := c.Get(1); valid || d != 0 {
+		t.Error("Expected entry 1 to be null but got", d, valid)
+	}
+	if d, valid := c.Get(3); !valid || d != 42 {
+		t.Error("Expected entry 3 to be Date(42) but got", d, valid)
+	}
+	n := 0
+	c.Range(func(int, Date, bool) bool { n++; return n < 4 })
+	if n != 4 {
+		t.Error("Expected Range to stop after 4 entries but got", n)
+	}
+}
+
+func TestNullableColumnJSON(t *testing.T) {
+	const want = `[null,"1970-01-02",null]`
+	var c NullableColumn
+	c.AppendNull()
+	c.Append(1)
+	c.AppendNull()
+	b, err := json.Marshal(&c)
+	if err != nil {
+		t.Fatal("Unexpected MarshalJSON error:", err)
+	} else if string(b) != want {
+		t.Fatalf("Expected MarshalJSON to return %#q but got %#q", want, b)
+	}
+	var rt NullableColumn
+	if err := json.Unmarshal(b, &rt); err != nil {
+		t.Fatal("Unexpected UnmarshalJSON error:", err)
+	}
+	if b2, _ := json.Marshal(&rt); string(b2) != want {
+		t.Errorf("Expected JSON round trip to return %#q but got %#q", want, b2)
+	}
+	if err := json.Unmarshal([]byte(`["bogus"]`), &rt); err == nil {
+		t.Error("Expected UnmarshalJSON to reject an invalid date")
+	}
+}
+
+func TestNullableColumnByValue(t *testing.T) {
+	var c NullableColumn
+	c.Append(1)
+	c.AppendNull()
+	b, err := json.Marshal(struct{ Col NullableColumn }{c})
+	if want := `{"Col":["1970-01-02",null]}`; err != nil || string(b) != want {
+		t.Errorf("Expected a column held by value to marshal as %s but got %s, %v", want, b, err)
+	}
+	var v encoding.BinaryMarshaler = c
+	if bin, err := v.MarshalBinary(); err != nil || len(bin) != 4+1+2*2 {
+		t.Errorf("Expected a column held by value to marshal to 9 bytes but got %x, %v", bin, err)
+	}
+}
+
+func TestNullableColumnBinaryPadding(t *testing.T) {
+	// One valid entry, with the unused bits of the bitmap set.
+	var c NullableColumn
+	if err := c.UnmarshalBinary([]byte{0, 0, 0, 1, 0xff, 0, 5}); err != nil {
+		t.Fatal("Unexpected UnmarshalBinary error:", err)
+	}
+	c.AppendNull()
+	if d, valid := c.Get(0); !valid || d != 5 {
+		t.Errorf("Expected entry 0 to be valid 1970-01-06 but got %s, %v", d, valid)
+	}
+	if d, valid := c.Get(1); valid || d != 0 {
+		t.Errorf("Expected an appended null to be invalid but got %s, %v", d, valid)
+	}
+}
+
+func TestNullableColumnBinary(t *testing.T) {
+	c := newTestColumn()
+	b, err := c.MarshalBinary()
+	if err != nil {
+		t.Fatal("Unexpected MarshalBinary error:", err)
+	}
+	if want := 4 + 2 + 2*10; len(b) != want {
+		t.Errorf("Expected %d byte encoding but got %d", want, len(b))
+	}
+	var rt NullableColumn
+	if err := rt.UnmarshalBinary(b); err != nil {
+		t.Fatal("Unexpected UnmarshalBinary error:", err)
+	}
+	j1, _ := json.Marshal(c)
+	j2, _ := json.Marshal(&rt)
+	if string(j1) != string(j2) {
+		t.Errorf("Expected binary round trip to return %s but got %s", j1, j2)
+	}
+	for _, bad := range [][]byte{nil, {0, 0, 0}, b[:len(b)-1], append(b, 0)} {
+		if err := rt.UnmarshalBinary(bad); err != ErrColumnFormat {
+			t.Errorf("Expected UnmarshalBinary(%v) to return ErrColumnFormat but got %v", bad, err)
+		}
+	}
+}
+
+func TestNullableColumnJSONTextLayout(t *testing.T) {
+	defer func(layout string) { TextLayout = layout }(TextLayout)
+	TextLayout = YYYYMMDD
+	var c NullableColumn
+	c.Append(15409)
+	c.AppendNull()
+	b, err := json.Marshal(&c)
+	if want := `["20120310",null]`; err != nil || string(b) != want {
+		t.Fatalf("Expected json.Marshal to return %s but got %s, %v", want, b, err)
+	}
+	var round NullableColumn
+	if err := json.Unmarshal(b, &round); err != nil || round.Len() != 2 {
+		t.Fatalf("Expected json.Unmarshal to round trip %s but got %v", b, err)
+	}
+	if d, valid := round.Get(0); !valid || d != 15409 {
+		t.Errorf("Expected the first entry to be 2012-03-10 but got %s, %v", d, valid)
+	}
+}
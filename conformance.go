@@ -0,0 +1,96 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"encoding/json"
+	"io"
+)
+
+//go:generate go run gen_conformance.go -o testdata/conformance.json
+
+// A ConformanceVector records the equivalent representations of a single
+// Date, for verifying other implementations of the 2-byte epoch-day format.
+type ConformanceVector struct {
+	EpochDay   int    `json:"epoch_day"`   // the Date value, days since 1970-01-01
+	Date       string `json:"date"`        // RFC3339 form, "2006-01-02"
+	Year       int    `json:"year"`        // proleptic Gregorian year
+	Month      int    `json:"month"`       // month, in [1,12]
+	Day        int    `json:"day"`         // day of month, in [1,31]
+	ISOYear    int    `json:"iso_year"`    // ISO-8601 week-numbering year
+	ISOWeek    int    `json:"iso_week"`    // ISO-8601 week, in [1,53]
+	ISOWeekday int    `json:"iso_weekday"` // ISO-8601 weekday, Monday = 1 through Sunday = 7
+	JulianDay  int64  `json:"jdn"`         // Julian Day Number (at noon UTC)
+}
+
+// ConformanceVectors returns a ConformanceVector for every representable
+// Date, in order. The file testdata/conformance.json, regenerated with
+// go generate, contains the subset of these vectors most likely to expose
+// bugs: each year's boundary from Dec 28 through Jan 4 (where ISO weeks
+// cross years), the days surrounding each Feb 29 or Mar 1, and the range
+// extremes.
+func ConformanceVectors() []ConformanceVector {
+	vectors := make([]ConformanceVector, 0, 1<<16)
+	for d := Date(0); ; d++ {
+		vectors = append(vectors, conformanceVector(d))
+		if d == MaxDate {
+			return vectors
+		}
+	}
+}
+
+func conformanceVector(d Date) ConformanceVector {
+	year, month, day := d.Date()
+	isoYear, isoWeek := d.ISOWeek()
+	return ConformanceVector{
+		EpochDay:   int(d),
+		Date:       d.String(),
+		Year:       year,
+		Month:      int(month),
+		Day:        day,
+		ISOYear:    isoYear,
+		ISOWeek:    isoWeek,
+		ISOWeekday: int(d.Weekday()+6)%7 + 1,
+		JulianDay:  d.JulianDay(),
+	}
+}
+
+// IsConformanceSample reports whether v belongs to the subset of vectors
+// published in testdata/conformance.json.
+func IsConformanceSample(v ConformanceVector) bool {
+	switch {
+	case v.EpochDay == 0, v.EpochDay == int(MaxDate):
+		return true
+	case v.Month == 12 && v.Day >= 28, v.Month == 1 && v.Day <= 4:
+		return true
+	case v.Month == 2 && v.Day >= 28, v.Month == 3 && v.Day == 1:
+		return true
+	}
+	return false
+}
+
+// WriteConformanceJSON writes the published subset of ConformanceVectors to
+// w as a JSON array, with one vector per line.
+func WriteConformanceJSON(w io.Writer) error {
+	sep := "[\n"
+	for _, v := range ConformanceVectors() {
+		if !IsConformanceSample(v) {
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+		sep = ",\n"
+	}
+	_, err := io.WriteString(w, "\n]\n")
+	return err
+}
@@ -0,0 +1,53 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestConformanceVectors(t *testing.T) {
+	vectors := ConformanceVectors()
+	if len(vectors) != 1<<16 {
+		t.Fatal("Expected a vector for each of 65536 dates but got", len(vectors))
+	}
+	for i, v := range vectors {
+		tm := time.Date(v.Year, time.Month(v.Month), v.Day, 0, 0, 0, 0, time.UTC)
+		isoYear, isoWeek := tm.ISOWeek()
+		if v.EpochDay != i || tm.Format(RFC3339) != v.Date || tm.Unix()/day != int64(i) ||
+			isoYear != v.ISOYear || isoWeek != v.ISOWeek || (int(tm.Weekday())+6)%7+1 != v.ISOWeekday {
+			t.Fatalf("Unexpected conformance vector %d: %+v", i, v)
+		}
+	}
+	// JDN 2451545 is 2000-01-01.
+	if v := vectors[10957]; v.JulianDay != 2451545 || v.Date != "2000-01-01" {
+		t.Errorf("Expected vector for 2000-01-01 to have JDN 2451545 but got %+v", v)
+	}
+}
+
+func TestConformanceFile(t *testing.T) {
+	data, err := os.ReadFile("testdata/conformance.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteConformanceJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, buf.Bytes()) {
+		t.Fatal("testdata/conformance.json is out of date; run go generate")
+	}
+	var vectors []ConformanceVector
+	if err := json.Unmarshal(data, &vectors); err != nil {
+		t.Fatal(err)
+	}
+	if first, last := vectors[0], vectors[len(vectors)-1]; first.Date != "1970-01-01" || last.Date != "2149-06-06" {
+		t.Error("Expected published vectors to span the full range but got", first.Date, "to", last.Date)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cqldate maps epochdate.Date to the Cassandra date type for
+// github.com/gocql/gocql.
+//
+// Since gocql only recognizes its Marshaler and Unmarshaler interfaces on the
+// values themselves, this package defines Date and NullDate types with the
+// same representation as their epochdate counterparts, to which pointers
+// may be converted when binding and scanning:
+//
+//	var d epochdate.Date
+//	err := session.Query(`SELECT day FROM events WHERE id = ?`, id).Scan((*cqldate.Date)(&d))
+//	err = session.Query(`INSERT INTO events (id, day) VALUES (?, ?)`, id, cqldate.Date(d)).Exec()
+//
+// The Cassandra date is an unsigned 32-bit day count centered on the epoch
+// (2^31 is Jan 1 1970). Timestamp columns, holding milliseconds since the
+// epoch, and text columns, holding RFC3339 dates regardless of
+// epochdate.TextLayout, are also supported.
+package cqldate
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/gocql/gocql"
+)
+
+// cqlEpoch is the Cassandra date value of Jan 1 1970.
+const cqlEpoch = 1 << 31
+
+const msPerDay = 24 * 60 * 60 * 1000
+
+// Date is an epochdate.Date which implements gocql.Marshaler and
+// gocql.Unmarshaler.
+type Date epochdate.Date
+
+// NullDate is an epochdate.NullDate which implements gocql.Marshaler and
+// gocql.Unmarshaler, representing invalid dates as null.
+type NullDate epochdate.NullDate
+
+// MarshalCQL implements gocql.Marshaler.
+func (d Date) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	switch info.Type() {
+	case gocql.TypeDate:
+		return binary.BigEndian.AppendUint32(nil, uint32(cqlEpoch+int64(d))), nil
+	case gocql.TypeTimestamp:
+		return binary.BigEndian.AppendUint64(nil, uint64(int64(d)*msPerDay)), nil
+	case gocql.TypeVarchar, gocql.TypeText, gocql.TypeAscii:
+		return epochdate.Date(d).AppendFormat(nil, epochdate.RFC3339), nil
+	}
+	return nil, fmt.Errorf("cqldate: cannot marshal Date into %s", info)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler. Null values cannot be
+// unmarshaled into a Date; use NullDate for nullable columns.
+func (d *Date) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("cqldate: cannot unmarshal null %s into Date", info)
+	}
+	var (
+		v   epochdate.Date
+		err error
+	)
+	switch info.Type() {
+	case gocql.TypeDate:
+		if len(data) != 4 {
+			return fmt.Errorf("cqldate: invalid length for date: %d", len(data))
+		}
+		v, err = epochdate.FromEpochDay(int64(binary.BigEndian.Uint32(data)) - cqlEpoch)
+	case gocql.TypeTimestamp:
+		if len(data) != 8 {
+			return fmt.Errorf("cqldate: invalid length for timestamp: %d", len(data))
+		}
+		v, err = epochdate.NewFromUnixMilli(int64(binary.BigEndian.Uint64(data)))
+	case gocql.TypeVarchar, gocql.TypeText, gocql.TypeAscii:
+		v, err = epochdate.ParseDateOnly(string(data))
+	default:
+		return fmt.Errorf("cqldate: cannot unmarshal %s into Date", info)
+	}
+	if err != nil {
+		return err
+	}
+	*d = Date(v)
+	return nil
+}
+
+// MarshalCQL implements gocql.Marshaler.
+func (n NullDate) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return Date(n.Date).MarshalCQL(info)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+func (n *NullDate) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if len(data) == 0 {
+		*n = NullDate{}
+		return nil
+	}
+	var d Date
+	if err := d.UnmarshalCQL(info, data); err != nil {
+		return err
+	}
+	*n = NullDate{Date: epochdate.Date(d), Valid: true}
+	return nil
+}
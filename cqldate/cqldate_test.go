@@ -0,0 +1,104 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cqldate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/gocql/gocql"
+)
+
+func nativeType(t gocql.Type) gocql.TypeInfo {
+	return gocql.NewNativeType(4, t, "")
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		typ gocql.Type
+		d   epochdate.Date
+		b   string
+	}{
+		{gocql.TypeDate, 0, "\x80\x00\x00\x00"},
+		{gocql.TypeDate, 15409, "\x80\x00\x3c\x31"},
+		{gocql.TypeTimestamp, 1, "\x00\x00\x00\x00\x05\x26\x5c\x00"},
+		{gocql.TypeText, 15409, "2012-03-10"},
+	}
+	for _, test := range tests {
+		info := nativeType(test.typ)
+		b, err := gocql.Marshal(info, Date(test.d))
+		if err != nil || string(b) != test.b {
+			t.Errorf("Expected Marshal(%s, %s) to return %x but got %x, %v", info, test.d, test.b, b, err)
+		}
+		var d epochdate.Date
+		if err := gocql.Unmarshal(info, []byte(test.b), (*Date)(&d)); err != nil || d != test.d {
+			t.Errorf("Expected Unmarshal(%s, %x) to return %s but got %s, %v", info, test.b, test.d, d, err)
+		}
+	}
+}
+
+func TestGocqlCompatible(t *testing.T) {
+	// The encoding must match that used by gocql for time.Time.
+	info := nativeType(gocql.TypeDate)
+	d := epochdate.Date(15409)
+	want, err := gocql.Marshal(info, d.UTC())
+	if err != nil {
+		t.Fatal("Unexpected Marshal error:", err)
+	}
+	if b, _ := gocql.Marshal(info, Date(d)); string(b) != string(want) {
+		t.Errorf("Expected Marshal(Date) to return %x, as for time.Time, but got %x", want, b)
+	}
+	var tm time.Time
+	if err := gocql.Unmarshal(info, want, &tm); err != nil || !tm.Equal(d.UTC()) {
+		t.Errorf("Expected gocql to decode %x as %v but got %v, %v", want, d.UTC(), tm, err)
+	}
+}
+
+func TestNull(t *testing.T) {
+	info := nativeType(gocql.TypeDate)
+	if b, err := gocql.Marshal(info, NullDate{}); err != nil || b != nil {
+		t.Errorf("Expected an invalid NullDate to marshal as null but got %x, %v", b, err)
+	}
+	n := NullDate{Date: 1, Valid: true}
+	if err := gocql.Unmarshal(info, nil, &n); err != nil || n.Valid {
+		t.Errorf("Expected null to unmarshal as an invalid NullDate but got %v, %v", n, err)
+	}
+	if err := gocql.Unmarshal(info, []byte("\x80\x00\x00\x01"), &n); err != nil || n != (NullDate{Date: 1, Valid: true}) {
+		t.Errorf("Expected Unmarshal to return a valid NullDate but got %v, %v", n, err)
+	}
+	var d Date
+	if err := gocql.Unmarshal(info, nil, &d); err == nil {
+		t.Error("Expected null to fail to unmarshal into a Date")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	var d Date
+	if _, err := gocql.Marshal(nativeType(gocql.TypeInt), d); err == nil {
+		t.Error("Expected Marshal into an int to return an error")
+	}
+	if err := gocql.Unmarshal(nativeType(gocql.TypeDate), []byte("\x7f\xff\xff\xff"), &d); !errors.Is(err, epochdate.ErrOutOfRange) {
+		t.Errorf("Expected Unmarshal of 1969-12-31 to return %v but got %v", epochdate.ErrOutOfRange, err)
+	}
+	if err := gocql.Unmarshal(nativeType(gocql.TypeDate), []byte("\x80\x00"), &d); err == nil {
+		t.Error("Expected Unmarshal of a 2-byte date to return an error")
+	}
+}
+
+func TestTextIgnoresTextLayout(t *testing.T) {
+	defer func(layout string) { epochdate.TextLayout = layout }(epochdate.TextLayout)
+	epochdate.TextLayout = epochdate.YYYYMMDD
+	info := nativeType(gocql.TypeText)
+	b, err := gocql.Marshal(info, Date(15409))
+	if err != nil || string(b) != "2012-03-10" {
+		t.Errorf("Expected Marshal to return 2012-03-10 but got %s, %v", b, err)
+	}
+	var d epochdate.Date
+	if err := gocql.Unmarshal(info, b, (*Date)(&d)); err != nil || d != 15409 {
+		t.Errorf("Expected Unmarshal to round trip %s but got %s, %v", b, d, err)
+	}
+}
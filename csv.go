@@ -0,0 +1,113 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"errors"
+	"strconv"
+)
+
+// MarshalCSV returns d in RFC3339 form. It implements the field Marshaler
+// interface of github.com/gocarina/gocsv; github.com/jszwec/csvutil uses
+// MarshalText instead.
+func (d Date) MarshalCSV() (string, error) {
+	return d.String(), nil
+}
+
+// UnmarshalCSV sets d from an RFC3339 date. It implements the field
+// Unmarshaler interface of github.com/gocarina/gocsv; github.com/jszwec/csvutil
+// uses UnmarshalText instead. To decode columns in other layouts, use
+// DecodeCSVColumn or CSVLayouts.
+func (d *Date) UnmarshalCSV(s string) error {
+	v, err := ParseDateOnly(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// A CSVError records a failure to decode a CSV field as a Date.
+type CSVError struct {
+	Record int    // index of the record in the input
+	Column int    // index of the field in the record
+	Name   string // name of the column, if known from a header
+	Err    error  // the *ParseError, or the reason the field is missing
+}
+
+func (e *CSVError) Error() string {
+	s := "epochdate: record " + strconv.Itoa(e.Record) + ", column " + strconv.Itoa(e.Column)
+	if e.Name != "" {
+		s += " (" + strconv.Quote(e.Name) + ")"
+	}
+	return s + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *CSVError) Unwrap() error {
+	return e.Err
+}
+
+var errMissingField = errors.New("missing field")
+
+// DecodeCSVColumn decodes field col of each of records, as produced by
+// csv.Reader.ReadAll, using layout as with Parse. Empty fields are decoded
+// as nulls. The first field which cannot be decoded results in a *CSVError.
+func DecodeCSVColumn(records [][]string, col int, layout string) (*NullableColumn, error) {
+	return decodeCSVColumn(records, 0, col, "", layout)
+}
+
+func decodeCSVColumn(records [][]string, start, col int, name, layout string) (*NullableColumn, error) {
+	c := new(NullableColumn)
+	for i := start; i < len(records); i++ {
+		if col >= len(records[i]) {
+			return nil, &CSVError{i, col, name, errMissingField}
+		}
+		v := records[i][col]
+		if v == "" {
+			c.AppendNull()
+			continue
+		}
+		d, err := Parse(layout, v)
+		if err != nil {
+			return nil, &CSVError{i, col, name, err}
+		}
+		c.Append(d)
+	}
+	return c, nil
+}
+
+// CSVLayouts maps the names of the date columns of a CSV file to the layout
+// used by each, for use with Decode.
+type CSVLayouts map[string]string
+
+// Decode decodes the date columns named in l from records, as produced by
+// csv.Reader.ReadAll, the first of which is the header. Each column is
+// decoded as with DecodeCSVColumn; other columns are ignored. The result
+// holds a column, excluding the header, for each name in l.
+func (l CSVLayouts) Decode(records [][]string) (map[string]*NullableColumn, error) {
+	if len(records) == 0 {
+		return nil, errors.New("epochdate: CSV input has no header")
+	}
+	index := make(map[string]int, len(records[0]))
+	for i, name := range records[0] {
+		if _, ok := index[name]; !ok {
+			index[name] = i
+		}
+	}
+	columns := make(map[string]*NullableColumn, len(l))
+	for name, layout := range l {
+		col, ok := index[name]
+		if !ok {
+			return nil, errors.New("epochdate: CSV header has no column " + strconv.Quote(name))
+		}
+		c, err := decodeCSVColumn(records, 1, col, name, layout)
+		if err != nil {
+			return nil, err
+		}
+		columns[name] = c
+	}
+	return columns, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCSVField(t *testing.T) {
+	d := Date(15409)
+	if s, err := d.MarshalCSV(); err != nil || s != "2012-03-10" {
+		t.Errorf("Expected MarshalCSV to return 2012-03-10 but got %s, %v", s, err)
+	}
+	var got Date
+	if err := got.UnmarshalCSV("2012-03-10"); err != nil || got != d {
+		t.Errorf("Expected UnmarshalCSV(2012-03-10) to return %s but got %s, %v", d, got, err)
+	}
+	got = 7
+	if err := got.UnmarshalCSV("03/10/2012"); err == nil || got != 7 {
+		t.Errorf("Expected UnmarshalCSV(03/10/2012) to fail and leave the date unchanged but got %s, %v", got, err)
+	}
+}
+
+const testCSV = `id,start,end
+1,03/10/2012,20120311
+2,01/01/1970,
+3,,21490606
+`
+
+func readTestCSV(t *testing.T) [][]string {
+	records, err := csv.NewReader(strings.NewReader(testCSV)).ReadAll()
+	if err != nil {
+		t.Fatal("Unexpected ReadAll error:", err)
+	}
+	return records
+}
+
+func columnString(c *NullableColumn) string {
+	var parts []string
+	c.Range(func(i int, d Date, valid bool) bool {
+		if valid {
+			parts = append(parts, d.String())
+		} else {
+			parts = append(parts, "null")
+		}
+		return true
+	})
+	return strings.Join(parts, " ")
+}
+
+func TestDecodeCSVColumn(t *testing.T) {
+	records := readTestCSV(t)
+	c, err := DecodeCSVColumn(records[1:], 1, "01/02/2006")
+	if err != nil {
+		t.Fatal("Unexpected DecodeCSVColumn error:", err)
+	}
+	if s, want := columnString(c), "2012-03-10 1970-01-01 null"; s != want {
+		t.Errorf("Expected DecodeCSVColumn to return %s but got %s", want, s)
+	}
+	_, err = DecodeCSVColumn(records, 1, "01/02/2006")
+	var cerr *CSVError
+	if !errors.As(err, &cerr) || cerr.Record != 0 || cerr.Column != 1 {
+		t.Errorf("Expected DecodeCSVColumn of the header to return a *CSVError for record 0, column 1 but got %v", err)
+	}
+	var perr *ParseError
+	if !errors.As(err, &perr) || perr.Value != "start" {
+		t.Errorf("Expected the *CSVError to wrap a *ParseError but got %v", err)
+	}
+	if _, err := DecodeCSVColumn(records, 3, RFC3339); !errors.Is(err, errMissingField) {
+		t.Errorf("Expected DecodeCSVColumn of a missing column to return %v but got %v", errMissingField, err)
+	}
+}
+
+func TestCSVLayouts(t *testing.T) {
+	records := readTestCSV(t)
+	columns, err := CSVLayouts{"start": "01/02/2006", "end": YYYYMMDD}.Decode(records)
+	if err != nil {
+		t.Fatal("Unexpected Decode error:", err)
+	}
+	tests := []struct {
+		name, want string
+	}{
+		{"start", "2012-03-10 1970-01-01 null"},
+		{"end", "2012-03-11 null 2149-06-06"},
+	}
+	for _, test := range tests {
+		if s := columnString(columns[test.name]); s != test.want {
+			t.Errorf("Expected the %s column to be %s but got %s", test.name, test.want, s)
+		}
+	}
+	if len(columns) != 2 {
+		t.Errorf("Expected Decode to return 2 columns but got %d", len(columns))
+	}
+	_, err = CSVLayouts{"end": "01/02/2006"}.Decode(records)
+	if want := `epochdate: record 1, column 2 ("end"): epochdate: parsing "20120311"`; err == nil || !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("Expected Decode with the wrong layout to return a *CSVError but got %v", err)
+	}
+	if _, err := (CSVLayouts{"due": RFC3339}).Decode(records); err == nil {
+		t.Error("Expected Decode of an unknown column to return an error")
+	}
+	if _, err := (CSVLayouts{}).Decode(nil); err == nil {
+		t.Error("Expected Decode of no records to return an error")
+	}
+}
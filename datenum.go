@@ -0,0 +1,96 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// DateNum is a Date which is encoded as its day number, such as 15409 for
+// 2012-03-10, rather than in RFC3339 form. This is the convention of the
+// Debezium io.debezium.time.Date and Kafka Connect Date types. In SQL, a
+// DateNum is stored as an integer rather than a DATE. Convert
+// between Date and DateNum as needed:
+//
+//	var v struct{ Day epochdate.DateNum }
+//	err := json.Unmarshal([]byte(`{"Day":15409}`), &v)
+//	d := epochdate.Date(v.Day)
+type DateNum Date
+
+// String returns the RFC3339 form of the date, as for Date.
+func (n DateNum) String() string {
+	return Date(n).String()
+}
+
+// MarshalText implements encoding.TextMarshaler, producing the day number in
+// decimal.
+func (n DateNum) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(n), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting a decimal day
+// number. An error wrapping ErrOutOfRange is returned for day numbers outside
+// of Date's representable range.
+func (n *DateNum) UnmarshalText(data []byte) error {
+	v, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return &ParseError{"", string(data), errSyntax}
+	}
+	d, err := FromEpochDay(v)
+	if err != nil {
+		return err
+	}
+	*n = DateNum(d)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, producing a JSON number.
+func (n DateNum) MarshalJSON() ([]byte, error) {
+	return n.MarshalText()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting a JSON integer. As for
+// Date, null leaves n unchanged.
+func (n *DateNum) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+	return n.UnmarshalText(data)
+}
+
+// Value implements driver.Valuer, storing n as its day number in an INTEGER
+// or SMALLINT column, which is more compact than a DATE in some databases and
+// allows range scans on the number directly. Note that a signed SMALLINT only
+// holds day numbers through 32767 (2059-09-18); use INTEGER for later dates.
+func (n DateNum) Value() (driver.Value, error) {
+	return int64(n), nil
+}
+
+// Scan implements sql.Scanner, accepting an integer day number, or its
+// decimal text for drivers which return numeric columns as text. As for
+// Date, NULL cannot be scanned into a DateNum; use sql.Null[DateNum] for
+// nullable columns.
+func (n *DateNum) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case int64:
+		d, err := FromEpochDay(v)
+		if err != nil {
+			return err
+		}
+		*n = DateNum(d)
+		return nil
+	case string:
+		return n.UnmarshalText([]byte(v))
+	case []byte:
+		return n.UnmarshalText(v)
+	case nil:
+		return errors.New("epochdate: cannot scan NULL into DateNum")
+	}
+	return fmt.Errorf("epochdate: cannot scan %T into DateNum", src)
+}
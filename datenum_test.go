@@ -0,0 +1,100 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestDateNumJSON(t *testing.T) {
+	type record struct {
+		Day DateNum
+		Ptr *DateNum `json:",omitempty"`
+	}
+	tests := []struct {
+		n    DateNum
+		json string
+	}{
+		{0, `{"Day":0}`},
+		{15409, `{"Day":15409}`},
+		{DateNum(^Date(0)), `{"Day":65535}`},
+	}
+	for _, test := range tests {
+		b, err := json.Marshal(record{Day: test.n})
+		if err != nil || string(b) != test.json {
+			t.Errorf("Expected JSON encoding of %d to be %s but got %s, %v", test.n, test.json, b, err)
+		}
+		var r record
+		if err := json.Unmarshal(b, &r); err != nil || r.Day != test.n {
+			t.Errorf("Expected JSON decoding of %s to return %d but got %d, %v", b, test.n, r.Day, err)
+		}
+	}
+	r := record{Day: 7}
+	if err := json.Unmarshal([]byte(`{"Day":null}`), &r); err != nil || r.Day != 7 {
+		t.Errorf("Expected null to leave the date unchanged but got %d, %v", r.Day, err)
+	}
+	if s := DateNum(15409).String(); s != "2012-03-10" {
+		t.Errorf("Expected DateNum(15409).String() to return 2012-03-10 but got %s", s)
+	}
+}
+
+func TestDateNumErrors(t *testing.T) {
+	tests := []struct {
+		json  string
+		error error
+	}{
+		{`"2012-03-10"`, errSyntax},
+		{`1.5`, errSyntax},
+		{`"15409"`, errSyntax},
+		{`-1`, ErrOutOfRange},
+		{`65536`, ErrOutOfRange},
+	}
+	for _, test := range tests {
+		n := DateNum(7)
+		err := json.Unmarshal([]byte(test.json), &n)
+		if !errors.Is(err, test.error) {
+			t.Errorf("Expected decoding %s to return %v but got %v", test.json, test.error, err)
+		}
+		if n != 7 {
+			t.Errorf("Expected failed decoding of %s to leave the date unchanged but got %d", test.json, n)
+		}
+	}
+}
+
+func TestDateNumSQL(t *testing.T) {
+	tests := []struct {
+		src  interface{}
+		want DateNum
+		ok   bool
+	}{
+		{int64(15409), 15409, true},
+		{int64(0), 0, true},
+		{int64(65535), 65535, true},
+		{"15409", 15409, true},
+		{[]byte("15409"), 15409, true},
+		{int64(-1), 0, false},
+		{int64(65536), 0, false},
+		{"2012-03-10", 0, false},
+		{nil, 0, false},
+		{3.5, 0, false},
+	}
+	for _, test := range tests {
+		var n DateNum
+		err := n.Scan(test.src)
+		if (err == nil) != test.ok || n != test.want {
+			t.Errorf("Expected Scan(%#v) to return %d, %v but got %d, %v", test.src, test.want, test.ok, n, err)
+		}
+	}
+	if v, err := DateNum(15409).Value(); err != nil || v != int64(15409) {
+		t.Errorf("Expected Value to return 15409 but got %#v, %v", v, err)
+	}
+	var n sql.Null[DateNum]
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Expected sql.Null[DateNum] to scan NULL as invalid but got %v, %v", n, err)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dynamodate stores epochdate.Date values in Amazon DynamoDB items
+// using github.com/aws/aws-sdk-go-v2.
+//
+// With the default options of the attributevalue package, Date fields are
+// stored as numbers holding the day count, since Date is a uint16. The
+// EncodeAsString and DecodeStrings options instead store and read them as
+// strings formatted using epochdate.TextLayout, through Date's MarshalText
+// and UnmarshalText methods; DecodeStrings still accepts numbers. Since those
+// options apply to every TextMarshaler and TextUnmarshaler in an item,
+// programs which need finer control can use String, Number, and Parse to
+// build and read attribute values directly.
+package dynamodate
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/extemporalgenome/epochdate"
+)
+
+// EncodeAsString configures an attributevalue encoder to store Dates as
+// strings formatted using epochdate.TextLayout. It sets the
+// UseEncodingMarshalers option.
+func EncodeAsString(o *attributevalue.EncoderOptions) {
+	o.UseEncodingMarshalers = true
+}
+
+// DecodeStrings configures an attributevalue decoder to read Dates stored as
+// strings formatted using epochdate.TextLayout, in addition to day counts. It
+// sets the UseEncodingUnmarshalers option.
+func DecodeStrings(o *attributevalue.DecoderOptions) {
+	o.UseEncodingUnmarshalers = true
+}
+
+// String returns d as a string attribute value formatted using
+// epochdate.TextLayout, as stored with EncodeAsString.
+func String(d epochdate.Date) types.AttributeValue {
+	b, _ := d.MarshalText()
+	return &types.AttributeValueMemberS{Value: string(b)}
+}
+
+// Number returns d as a number attribute value holding its day count.
+func Number(d epochdate.Date) types.AttributeValue {
+	return &types.AttributeValueMemberN{Value: strconv.Itoa(d.Days())}
+}
+
+// Parse returns the Date held by av, which may be a string attribute value
+// formatted using epochdate.TextLayout, or a number attribute value holding a
+// day count.
+func Parse(av types.AttributeValue) (epochdate.Date, error) {
+	switch av := av.(type) {
+	case *types.AttributeValueMemberS:
+		var d epochdate.Date
+		err := d.UnmarshalText([]byte(av.Value))
+		return d, err
+	case *types.AttributeValueMemberN:
+		n, err := strconv.ParseInt(av.Value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("dynamodate: invalid day count %q", av.Value)
+		}
+		return epochdate.FromEpochDay(n)
+	}
+	return 0, fmt.Errorf("dynamodate: cannot parse %T as a Date", av)
+}
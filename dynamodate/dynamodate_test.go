@@ -0,0 +1,96 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dynamodate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/extemporalgenome/epochdate"
+)
+
+type item struct {
+	ID  string         `dynamodbav:"id"`
+	Day epochdate.Date `dynamodbav:"day"`
+}
+
+func TestDefaultEncoding(t *testing.T) {
+	m, err := attributevalue.MarshalMap(item{"a", 15409})
+	if err != nil {
+		t.Fatal("Unexpected MarshalMap error:", err)
+	}
+	if n, ok := m["day"].(*types.AttributeValueMemberN); !ok || n.Value != "15409" {
+		t.Errorf("Expected day to be stored as the number 15409 but got %#v", m["day"])
+	}
+	var out item
+	if err := attributevalue.UnmarshalMap(m, &out); err != nil || out.Day != 15409 {
+		t.Errorf("Expected UnmarshalMap to return 15409 but got %d, %v", out.Day, err)
+	}
+}
+
+func TestStringEncoding(t *testing.T) {
+	m, err := attributevalue.MarshalMapWithOptions(item{"a", 15409}, EncodeAsString)
+	if err != nil {
+		t.Fatal("Unexpected MarshalMap error:", err)
+	}
+	if s, ok := m["day"].(*types.AttributeValueMemberS); !ok || s.Value != "2012-03-10" {
+		t.Errorf("Expected day to be stored as the string 2012-03-10 but got %#v", m["day"])
+	}
+	var out item
+	if err := attributevalue.UnmarshalMapWithOptions(m, &out, DecodeStrings); err != nil || out.Day != 15409 {
+		t.Errorf("Expected UnmarshalMap to return 15409 but got %d, %v", out.Day, err)
+	}
+	m["day"] = Number(1)
+	if err := attributevalue.UnmarshalMapWithOptions(m, &out, DecodeStrings); err != nil || out.Day != 1 {
+		t.Errorf("Expected UnmarshalMap of a number to return 1 but got %d, %v", out.Day, err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, av := range []types.AttributeValue{String(15409), Number(15409)} {
+		if d, err := Parse(av); err != nil || d != 15409 {
+			t.Errorf("Expected Parse(%#v) to return 15409 but got %d, %v", av, d, err)
+		}
+	}
+	tests := []types.AttributeValue{
+		&types.AttributeValueMemberS{Value: "2012-03-1"},
+		&types.AttributeValueMemberN{Value: "1.5"},
+		&types.AttributeValueMemberNULL{Value: true},
+	}
+	for _, av := range tests {
+		if _, err := Parse(av); err == nil {
+			t.Errorf("Expected Parse(%#v) to return an error", av)
+		}
+	}
+	if _, err := Parse(&types.AttributeValueMemberN{Value: "-1"}); !errors.Is(err, epochdate.ErrOutOfRange) {
+		t.Errorf("Expected Parse(-1) to return %v but got %v", epochdate.ErrOutOfRange, err)
+	}
+}
+
+func TestStringTextLayout(t *testing.T) {
+	defer func(layout string) { epochdate.TextLayout = layout }(epochdate.TextLayout)
+	epochdate.TextLayout = epochdate.YYYYMMDD
+	m, err := attributevalue.MarshalMapWithOptions(item{"a", 15409}, EncodeAsString)
+	if err != nil {
+		t.Fatal("Unexpected MarshalMap error:", err)
+	}
+	if s, ok := m["day"].(*types.AttributeValueMemberS); !ok || s.Value != "20120310" {
+		t.Errorf("Expected day to be stored as the string 20120310 but got %#v", m["day"])
+	}
+	if s := String(15409).(*types.AttributeValueMemberS); s.Value != "20120310" {
+		t.Errorf("Expected String to return 20120310 but got %s", s.Value)
+	}
+	for _, av := range []types.AttributeValue{m["day"], String(15409)} {
+		if d, err := Parse(av); err != nil || d != 15409 {
+			t.Errorf("Expected Parse(%#v) to return 15409 but got %d, %v", av, d, err)
+		}
+	}
+	var out item
+	if err := attributevalue.UnmarshalMapWithOptions(m, &out, DecodeStrings); err != nil || out.Day != 15409 {
+		t.Errorf("Expected UnmarshalMap to return 15409 but got %d, %v", out.Day, err)
+	}
+}
@@ -22,61 +22,464 @@
 // All functions and methods with the same names as those found in the stdlib
 // time package have identical semantics in epochdate, with the exception that
 // epochdate truncates time-of-day information.
+//
+// Package epochdate depends only upon the standard library. Integrations
+// with other packages, such as database drivers, serialization formats, and
+// testing libraries, are provided by subpackages, so that programs only
+// depend upon those they import.
 package epochdate
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
+	"log/slog"
+	"math"
+	"reflect"
+	"strconv"
 	"time"
 )
 
 const (
 	day      = 60 * 60 * 24
+	jdnEpoch = 2440588 // Julian Day Number of Jan 1 1970
+	mjdEpoch = 40587   // Modified Julian Date of Jan 1 1970
+	rdEpoch  = 719163  // Rata Die day number of Jan 1 1970
 	nsPerSec = 1e9
 	maxUnix  = (1<<16)*day - 1
 )
 
+// The bounds of Date's representable range.
+const (
+	MinDate Date = 0         // 1970-01-01
+	MaxDate Date = 1<<16 - 1 // 2149-06-06
+)
+
+// Epoch is midnight UTC on MinDate, the instant from which Dates are counted.
+var Epoch = time.Unix(0, 0).UTC()
+
 // Format constants, for use with Parse and the Date.Format method.
 const (
 	RFC3339        = "2006-01-02"
 	AmericanShort  = "1-2-06"
 	AmericanCommon = "01-02-06"
+	EuropeanSlash  = "02/01/2006"
+	EuropeanDot    = "02.01.2006"
+
+	// YYYYMMDD is the compact 8-digit form, such as "20120310", commonly
+	// used by data warehouses as an integer key; see also FromYYYYMMDD.
+	YYYYMMDD = "20060102"
+
+	// Oracle matches the default Oracle NLS_DATE_FORMAT ("DD-MON-RR"). Month
+	// names are matched case-insensitively, so "02-JAN-06" is accepted. Parse
+	// applies its own two-digit year window rather than Oracle's RR rule, so
+	// "02-JAN-55", which Oracle currently reads as 1955, is parsed as 2055.
+	// ParsePivot(Oracle, value, 1950) follows RR instead, returning
+	// ErrOutOfRange for the years 50 through 69.
+	Oracle = "02-Jan-06"
+
+	// SQLServer is the legacy SQL Server datetime text form, as produced by
+	// CONVERT with style 0 or 100. Its time-of-day is ignored.
+	SQLServer = "Jan _2 2006 3:04PM"
+
+	// ISOOrdinal is the ISO-8601 ordinal date form, such as "2012-070",
+	// consisting of the year and the 3-digit day of the year.
+	ISOOrdinal = "2006-002"
+
+	// ISOWeekDate and ISOWeekOnly are the ISO-8601 week date forms, such as
+	// "2012-W10-6" (weekday 1 is Monday) and "2012-W10", in which the year is
+	// the ISO week-numbering year. The time package has no equivalent, so
+	// these are only recognized as complete layouts, and not in combination
+	// with other layout elements. When parsing ISOWeekOnly, the date of the
+	// Monday of the week is returned.
+	ISOWeekDate = "2006-Www-D"
+	ISOWeekOnly = "2006-Www"
 )
 
-// ErrOutOfRange is returned if the input date is not a representable Date.
+// A Unit is a calendar period by which a Date may be truncated.
+type Unit int
+
+// Units accepted by the Date.Truncate method. Weeks begin on Monday, per
+// ISO-8601; use Date.StartOfWeek for other conventions.
+const (
+	Week Unit = iota
+	Month
+	Quarter
+	Year
+)
+
+// A Weekend is a set of weekdays which are not working days.
+type Weekend uint8
+
+// Common weekend definitions, for use with Date.IsWeekendIn and
+// Date.IsWeekdayIn.
+const (
+	SaturdaySunday Weekend = 1<<time.Saturday | 1<<time.Sunday
+	FridaySaturday Weekend = 1<<time.Friday | 1<<time.Saturday
+	SundayOnly     Weekend = 1 << time.Sunday
+)
+
+// NewWeekend returns a Weekend consisting of the given days.
+func NewWeekend(days ...time.Weekday) Weekend {
+	var w Weekend
+	for _, wd := range days {
+		w |= 1 << uint(wd)
+	}
+	return w
+}
+
+// Contains reports whether wd is part of the weekend.
+func (w Weekend) Contains(wd time.Weekday) bool {
+	return w&(1<<uint(wd)) != 0
+}
+
+// ErrOutOfRange is returned, wrapped in a *RangeError, if the input date is not
+// a representable Date.
 var ErrOutOfRange = errors.New("epochdate: dates must be in the range [1970-01-01,2149-06-06]")
 
+// A RangeError records a value which is not a representable Date. It wraps
+// ErrOutOfRange, so errors.Is(err, ErrOutOfRange) is true for any
+// *RangeError.
+type RangeError struct {
+	Days int64 // the offending value, as a count of days since Jan 1 1970
+	Unix int64 // the offending value, as a Unix timestamp per NewFromUnix
+
+	// The offending value as a UTC calendar date. These are zero, and Unix
+	// saturates, if Days is too distant for the date to be computed.
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// maxErrorDays bounds the day counts for which a RangeError records a
+// calendar date; it is far beyond any year the time package can format, yet
+// small enough that multiplying by day cannot overflow.
+const maxErrorDays = 1 << 40
+
+// rangeError returns a *RangeError for the Unix timestamp unix.
+func rangeError(unix int64) error {
+	year, month, mday := time.Unix(unix, 0).UTC().Date()
+	return &RangeError{floorDiv(unix, day), unix, year, month, mday}
+}
+
+// dayRangeError returns a *RangeError for the count of days since Jan 1 1970.
+func dayRangeError(days int64) error {
+	switch {
+	case days > maxErrorDays:
+		return &RangeError{Days: days, Unix: math.MaxInt64}
+	case days < -maxErrorDays:
+		return &RangeError{Days: days, Unix: math.MinInt64}
+	}
+	return rangeError(days * day)
+}
+
+func (e *RangeError) Error() string {
+	if e.Month == 0 {
+		return ErrOutOfRange.Error() + "; got " + strconv.FormatInt(e.Days, 10) + " days from 1970-01-01"
+	}
+	return ErrOutOfRange.Error() + "; got " + time.Date(e.Year, e.Month, e.Day, 0, 0, 0, 0, time.UTC).Format(RFC3339)
+}
+
+// Unwrap returns ErrOutOfRange.
+func (e *RangeError) Unwrap() error {
+	return ErrOutOfRange
+}
+
+// A ParseError describes a failure to parse a value as a Date. Err is the
+// underlying cause: ErrOutOfRange if the value denotes a date which cannot be
+// represented, ErrInvalidDate if its components do not form a calendar date,
+// or otherwise an error describing why the value does not match the layout,
+// which is a *time.ParseError if the time package was used in parsing.
+type ParseError struct {
+	Layout string // the layout used, or empty if several were tried
+	Value  string // the value being parsed
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	s := "epochdate: parsing " + strconv.Quote(e.Value)
+	if e.Layout != "" {
+		s += " as " + strconv.Quote(e.Layout)
+	}
+	return s + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error, for use with errors.Is and errors.As.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Causes of ParseError for values not matching a layout in the hand-written
+// parsers, in lieu of a *time.ParseError.
+var (
+	errSyntax       = errors.New("malformed value")
+	errMonthRange   = errors.New("month out of range")
+	errDayRange     = errors.New("day out of range")
+	errWeekRange    = errors.New("week out of range")
+	errWeekdayRange = errors.New("weekday out of range")
+	errNoLayout     = errors.New("no layout matched")
+)
+
+// ErrInvalidDate is returned if the input does not identify a date on the
+// calendar, such as the fifth Monday of a month which only has four.
+var ErrInvalidDate = errors.New("epochdate: no such date")
+
 // Date stores the number of days since Jan 1, 1970. The last representable
 // date is June 6, 2149.
 type Date uint16
 
+// Now returns the current instant, from which Today, TodayUTC, Tomorrow, and
+// Yesterday are derived. Tests and simulations may replace it to control the
+// current date; as for TextLayout, it is read without synchronization, so it
+// must not be changed while other goroutines may be reading it:
+//
+//	defer func(now func() time.Time) { epochdate.Now = now }(epochdate.Now)
+//	epochdate.Now = func() time.Time { return time.Date(2012, 3, 10, 12, 0, 0, 0, time.Local) }
+var Now = time.Now
+
 // Today returns the local date at this instant. If the local date does not
 // fall within the representable range, then then zero value will be returned
-// (1970-01-01).
+// (1970-01-01); use TodayErr to detect this.
 func Today() Date {
-	date, err := NewFromTime(time.Now())
-	if err != nil {
-		return 0
-	}
+	date, _ := TodayErr()
 	return date
 }
 
 // TodayUTC returns the date at this instant, relative to UTC. If the UTC
 // date does not fall within the representable range, then then zero value
-// will be returned (1970-01-01).
+// will be returned (1970-01-01); use TodayUTCErr to detect this.
 func TodayUTC() Date {
-	date, _ := NewFromTime(time.Now().UTC())
+	date, _ := TodayUTCErr()
 	return date
 }
 
+// TodayIn returns the date at this instant in loc, such as a customer's time
+// zone. If that date does not fall within the representable range, then the
+// zero value will be returned (1970-01-01); use TodayInErr to detect this.
+func TodayIn(loc *time.Location) Date {
+	date, _ := TodayInErr(loc)
+	return date
+}
+
+// TodayErr is like Today, but returns an error wrapping ErrOutOfRange if the
+// local date does not fall within the representable range, rather than a
+// zero value indistinguishable from Jan 1 1970.
+func TodayErr() (Date, error) {
+	return NewFromTime(Now())
+}
+
+// TodayUTCErr is like TodayUTC, but returns an error wrapping ErrOutOfRange
+// if the UTC date does not fall within the representable range.
+func TodayUTCErr() (Date, error) {
+	return NewFromTime(Now().UTC())
+}
+
+// TodayInErr is like TodayIn, but returns an error wrapping ErrOutOfRange if
+// the date in loc does not fall within the representable range.
+func TodayInErr(loc *time.Location) (Date, error) {
+	return NewFromTime(Now().In(loc))
+}
+
+// Tomorrow returns the local date following Today.
+func Tomorrow() Date {
+	return Today().Next()
+}
+
+// Yesterday returns the local date preceding Today.
+func Yesterday() Date {
+	return Today().Prev()
+}
+
 // Parse follows the same semantics as time.Parse, but ignores time-of-day
-// information and returns a Date value.
+// information and returns a Date value. Additionally, the ISOWeekDate and
+// ISOWeekOnly layouts are supported, though only as complete layouts. Any
+// error returned is a *ParseError.
 func Parse(layout, value string) (d Date, err error) {
+	switch layout {
+	case ISOWeekDate, ISOWeekOnly:
+		return parseISOWeek(layout, value)
+	}
 	t, err := time.Parse(layout, value)
 	if err == nil {
 		d, err = NewFromTime(t)
 	}
-	return
+	if err != nil {
+		return 0, &ParseError{layout, value, err}
+	}
+	return d, nil
+}
+
+// IsLeapYear reports whether year is a leap year in the proleptic Gregorian
+// calendar.
+func IsLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// DaysInYear returns the number of days in year: 366 for leap years,
+// otherwise 365.
+func DaysInYear(year int) int {
+	if IsLeapYear(year) {
+		return 366
+	}
+	return 365
+}
+
+// DaysInMonth returns the number of days in the given month of year. It
+// returns 0 if m is not a valid month.
+func DaysInMonth(year int, m time.Month) int {
+	switch m {
+	case time.February:
+		if IsLeapYear(year) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	case time.January, time.March, time.May, time.July, time.August, time.October, time.December:
+		return 31
+	}
+	return 0
+}
+
+// NthWeekdayOfMonth returns the date of the nth occurrence of the weekday wd
+// in the given month, such as the third Thursday for n = 3. Negative values of
+// n count backward from the end of the month, so -1 is the last occurrence.
+// ErrInvalidDate is returned if the month does not have such an occurrence.
+func NthWeekdayOfMonth(year int, m time.Month, wd time.Weekday, n int) (Date, error) {
+	dim := DaysInMonth(year, m)
+	if dim == 0 || n == 0 {
+		return 0, ErrInvalidDate
+	}
+	var day int
+	if n > 0 {
+		first := weekday(fromCivil(year, int(m), 1))
+		day = 1 + int(wd-first+7)%7 + (n-1)*7
+	} else {
+		last := weekday(fromCivil(year, int(m), dim))
+		day = dim - int(last-wd+7)%7 + (n+1)*7
+	}
+	if day < 1 || day > dim {
+		return 0, ErrInvalidDate
+	}
+	return NewFromDate(year, m, day)
+}
+
+// LastWeekdayOfMonth returns the date of the last occurrence of the weekday
+// wd in the given month. It is equivalent to NthWeekdayOfMonth with n = -1.
+func LastWeekdayOfMonth(year int, m time.Month, wd time.Weekday) (Date, error) {
+	return NthWeekdayOfMonth(year, m, wd, -1)
+}
+
+// ParseInLocation follows the same semantics as time.ParseInLocation, but
+// ignores time-of-day information and returns a Date value. Values without
+// zone information are interpreted in loc, and as with NewFromTime, the date
+// on the resulting wall clock is retained. Values which do include an
+// explicit zone offset retain the date in that zone, rather than being
+// converted to loc; to obtain the date in loc at the parsed instant, use
+// time.Parse and NewFromTime(t.In(loc)).
+func ParseInLocation(layout, value string, loc *time.Location) (Date, error) {
+	switch layout {
+	case ISOWeekDate, ISOWeekOnly:
+		return parseISOWeek(layout, value)
+	}
+	t, err := time.ParseInLocation(layout, value, loc)
+	var d Date
+	if err == nil {
+		d, err = NewFromTime(t)
+	}
+	if err != nil {
+		return 0, &ParseError{layout, value, err}
+	}
+	return d, nil
+}
+
+// ParseDateOnly parses an RFC3339 date of the form "2006-01-02". It is
+// equivalent to Parse(RFC3339, s), but considerably faster, since it does not
+// use time.Parse. As with Parse, any error returned is a *ParseError.
+func ParseDateOnly(s string) (Date, error) {
+	if len(s) != len(RFC3339) || s[4] != '-' || s[7] != '-' {
+		return 0, &ParseError{RFC3339, s, errSyntax}
+	}
+	year, ok1 := atoi(s[0:4])
+	month, ok2 := atoi(s[5:7])
+	day, ok3 := atoi(s[8:10])
+	if !ok1 || !ok2 || !ok3 {
+		return 0, &ParseError{RFC3339, s, errSyntax}
+	} else if month < 1 || month > 12 {
+		return 0, &ParseError{RFC3339, s, errMonthRange}
+	} else if day < 1 || day > DaysInMonth(year, time.Month(month)) {
+		return 0, &ParseError{RFC3339, s, errDayRange}
+	}
+	d, err := FromDays(fromCivil(year, month, day))
+	if err != nil {
+		return 0, &ParseError{RFC3339, s, err}
+	}
+	return d, nil
+}
+
+// atoi parses a non-empty string of ASCII decimal digits.
+func atoi(s string) (n int, ok bool) {
+	for i := 0; i < len(s); i++ {
+		c := s[i] - '0'
+		if c > 9 {
+			return 0, false
+		}
+		n = n*10 + int(c)
+	}
+	return n, len(s) > 0
+}
+
+// DefaultLayouts are the layouts tried by ParseAny when none are given: RFC3339
+// dates and timestamps, compact YYYYMMDD, slash-separated year-first and
+// US month-first forms, dot-separated European day-first forms, and English
+// month names. Since "01/02/2006" is interpreted as month-first, supply
+// layouts explicitly when day-first slash-separated input is expected.
+var DefaultLayouts = []string{
+	RFC3339,
+	time.RFC3339,
+	YYYYMMDD,
+	"2006/01/02",
+	"01/02/2006",
+	"02.01.2006",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"2 Jan 2006",
+	"2 January 2006",
+}
+
+// ParseAny attempts to parse value using each of layouts in turn, returning
+// the first successful result. If no layouts are given, DefaultLayouts are
+// used. If value matches a layout but is not a representable date, the
+// *ParseError from that layout, wrapping ErrOutOfRange, is returned;
+// otherwise a *ParseError with an empty Layout is returned.
+func ParseAny(value string, layouts ...string) (Date, error) {
+	if len(layouts) == 0 {
+		layouts = DefaultLayouts
+	}
+	var rangeErr error
+	for _, layout := range layouts {
+		d, err := Parse(layout, value)
+		if err == nil {
+			return d, nil
+		} else if errors.Is(err, ErrOutOfRange) {
+			rangeErr = err
+		}
+	}
+	if rangeErr != nil {
+		return 0, rangeErr
+	}
+	return 0, &ParseError{"", value, errNoLayout}
+}
+
+// MustParse is like Parse but panics if the value cannot be parsed. It
+// simplifies safe initialization of Date values from known-valid inputs, such
+// as package-level variables and test fixtures.
+func MustParse(layout, value string) Date {
+	d, err := Parse(layout, value)
+	if err != nil {
+		panic(`epochdate: Parse(` + strconv.Quote(layout) + `, ` + strconv.Quote(value) + `): ` + err.Error())
+	}
+	return d
 }
 
 // NewFromTime returns a Date equivalent to NewFromDate(t.Date()),
@@ -93,6 +496,66 @@ func NewFromDate(year int, month time.Month, day int) (Date, error) {
 	return NewFromUnix(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix())
 }
 
+// NewFromDateStrict is like NewFromDate, but rather than normalizing
+// out-of-range components (so that Feb 30 becomes Mar 1 or 2, as with
+// time.Date), ErrInvalidDate is returned if they do not form a calendar date.
+//
+// Parsing is already strict in this sense: Parse, ParseDateOnly, and the
+// other parsing functions reject values such as "2024-02-30".
+func NewFromDateStrict(year int, month time.Month, day int) (Date, error) {
+	if day < 1 || day > DaysInMonth(year, month) {
+		return 0, ErrInvalidDate
+	}
+	return NewFromDate(year, month, day)
+}
+
+// MustNewFromDate is like NewFromDate but panics if the date is not
+// representable.
+func MustNewFromDate(year int, month time.Month, day int) Date {
+	d, err := NewFromDate(year, month, day)
+	if err != nil {
+		panic("epochdate: NewFromDate(" + strconv.Itoa(year) + ", " + month.String() + ", " + strconv.Itoa(day) + "): " + err.Error())
+	}
+	return d
+}
+
+// FromYYYYMMDD returns the date represented by an integer of the form
+// 20120310. It is the inverse of Date.YYYYMMDD. ErrInvalidDate is returned if
+// the month or day components do not form a calendar date, and
+// ErrOutOfRange if the date is not representable.
+func FromYYYYMMDD(n int) (Date, error) {
+	year, month, day := n/10000, n/100%100, n%100
+	if n < 0 || month < 1 || month > 12 || day < 1 || day > DaysInMonth(year, time.Month(month)) {
+		return 0, ErrInvalidDate
+	}
+	return FromDays(fromCivil(year, month, day))
+}
+
+// FromYearDay returns the date of the given 1-based ordinal day of year, so
+// that FromYearDay(2012, 60) is Feb 29 2012. It is the inverse of
+// Date.YearDay. ErrInvalidDate is returned if day is not within the year (for
+// example, day 366 of a non-leap year), and ErrOutOfRange if the date is not
+// representable.
+func FromYearDay(year, day int) (Date, error) {
+	if day < 1 || day > DaysInYear(year) {
+		return 0, ErrInvalidDate
+	}
+	return FromDays(fromCivil(year, 1, 1) + day - 1)
+}
+
+// FromISOWeek returns the date falling on weekday wd of the given ISO-8601
+// week. It is the inverse of Date.ISOWeek. ErrInvalidDate is returned if the
+// ISO year does not have such a week (only some years have a week 53), and
+// ErrOutOfRange if the date is not representable.
+func FromISOWeek(year, week int, wd time.Weekday) (Date, error) {
+	start := firstWeekStart(year, time.Monday, 4)
+	weeks := (firstWeekStart(year+1, time.Monday, 4) - start) / 7
+	if week < 1 || week > weeks || wd < time.Sunday || wd > time.Saturday {
+		return 0, ErrInvalidDate
+	}
+	return FromDays(start + (week-1)*7 + int(wd+6)%7)
+}
+
 // NewFromUnix creates a Date from a Unix timestamp, relative to any location
 // Specifically, if you pass in t.Unix(), where t is a time.Time value with a
 // non-UTC zone, you may receive an unexpected Date. Unless this behavior is
@@ -103,11 +566,93 @@ func NewFromUnix(seconds int64) (d Date, err error) {
 	if UnixInRange(seconds) {
 		d = Date(seconds / day)
 	} else {
-		err = ErrOutOfRange
+		err = rangeError(seconds)
 	}
 	return
 }
 
+// NewFromUnixMilli is like NewFromUnix, but accepts a Unix timestamp in
+// milliseconds, as commonly exchanged with JavaScript.
+func NewFromUnixMilli(msec int64) (Date, error) {
+	return NewFromUnix(floorDiv(msec, 1e3))
+}
+
+// NewFromUnixMicro is like NewFromUnix, but accepts a Unix timestamp in
+// microseconds.
+func NewFromUnixMicro(usec int64) (Date, error) {
+	return NewFromUnix(floorDiv(usec, 1e6))
+}
+
+// floorDiv returns a/b rounded toward negative infinity, so that instants
+// before the epoch are never truncated into its first second.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
+
+// FromDays returns the Date n days after Jan 1 1970. Unlike a direct
+// conversion such as Date(n), which silently wraps values outside of the
+// representable range, FromDays returns ErrOutOfRange for such values.
+func FromDays(n int) (Date, error) {
+	if n < 0 || n > int(MaxDate) {
+		return 0, dayRangeError(int64(n))
+	}
+	return Date(n), nil
+}
+
+// FromEpochDay returns the Date corresponding to an epoch-day count, as
+// produced by java.time.LocalDate.toEpochDay or NodaTime's
+// LocalDate.DaysSinceEpoch (days elapsed since Jan 1 1970, negative for
+// earlier dates). ErrOutOfRange is returned for counts outside of Date's
+// representable range.
+func FromEpochDay(n int64) (Date, error) {
+	if n < 0 || n > int64(MaxDate) {
+		return 0, dayRangeError(n)
+	}
+	return Date(n), nil
+}
+
+// FromJulianDay returns the Date corresponding to a Julian Day Number, the
+// integer count of days used in astronomy, in which Jan 1 1970 is day
+// 2440588. Since Julian days begin at noon UTC, the result is the (UTC) date
+// on which the Julian day begins. ErrOutOfRange is returned for days outside
+// of Date's representable range.
+func FromJulianDay(jdn int64) (Date, error) {
+	return fromDayNumber(jdn, jdnEpoch)
+}
+
+// FromModifiedJulianDay returns the Date corresponding to a Modified Julian
+// Date day number (JD - 2400000.5), which, unlike the Julian Day, begins at
+// midnight UTC; Jan 1 1970 is MJD 40587. ErrOutOfRange is returned for days
+// outside of Date's representable range.
+func FromModifiedJulianDay(mjd int64) (Date, error) {
+	return fromDayNumber(mjd, mjdEpoch)
+}
+
+// FromRataDie returns the Date corresponding to a Rata Die day number, as
+// used by Calendrical Calculations, in which Jan 1 of year 1 (proleptic
+// Gregorian) is day 1, and Jan 1 1970 is day 719163. ErrOutOfRange is
+// returned for days outside of Date's representable range.
+func FromRataDie(rd int64) (Date, error) {
+	return fromDayNumber(rd, rdEpoch)
+}
+
+// fromDayNumber returns the Date for day number n, of a day-numbering system
+// in which Jan 1 1970 is epoch.
+func fromDayNumber(n, epoch int64) (Date, error) {
+	if n < epoch || n-epoch > int64(MaxDate) {
+		days := n - epoch
+		if n < epoch && days > 0 {
+			days = math.MinInt64 // n - epoch underflowed
+		}
+		return 0, dayRangeError(days)
+	}
+	return Date(n - epoch), nil
+}
+
 // UnixInRange is true if the provided Unix timestamp is in Date's
 // representable range. The timestamp is interpreted according to the semantics
 // used by NewFromUnix. You probably won't need to use this, since this will
@@ -116,9 +661,134 @@ func UnixInRange(seconds int64) bool {
 	return seconds >= 0 && seconds <= maxUnix
 }
 
+// InRange is true if the date of t, in t's location, is in Date's
+// representable range, which is to say that NewFromTime(t) would succeed.
+func InRange(t time.Time) bool {
+	_, offset := t.Zone()
+	return UnixInRange(t.Unix() + int64(offset))
+}
+
 // Returns an RFC3339/ISO-8601 date string, of the form "2006-01-02".
+//
+// Through String, the fmt package prints Dates in this form for the %v, %s,
+// and %q verbs, honoring width and precision, while %d and the other integer
+// verbs print the underlying day number.
 func (d Date) String() string {
-	return d.Format(RFC3339)
+	var buf [len(RFC3339)]byte
+	return string(d.appendRFC3339(buf[:0]))
+}
+
+// LogValue implements slog.LogValuer, so that structured loggers record d as
+// a string attribute in RFC3339 form.
+func (d Date) LogValue() slog.Value {
+	return slog.StringValue(d.String())
+}
+
+// appendRFC3339 appends d to b in RFC3339 form without going through
+// time.Time.Format, whose layout interpretation dominates the cost of
+// formatting such a small value.
+func (d Date) appendRFC3339(b []byte) []byte {
+	year, month, day := civil(int(d))
+	return append(b,
+		byte('0'+year/1000), byte('0'+year/100%10), byte('0'+year/10%10), byte('0'+year%10), '-',
+		byte('0'+month/10), byte('0'+month%10), '-',
+		byte('0'+day/10), byte('0'+day%10))
+}
+
+// fromCivil is the inverse of civil, accepting a proleptic Gregorian year,
+// month, and day, and returning the number of days since Jan 1 1970. For
+// dates in the range supported by civil, the input must be normalized.
+func fromCivil(year, month, day int) int {
+	if month <= 2 {
+		year--
+	}
+	era := year / 400
+	yoe := year - era*400
+	mp := (month + 9) % 12
+	doy := (153*mp+2)/5 + day - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+	return era*146097 + doe - 719468
+}
+
+// civil converts a count of days since Jan 1 1970 into a proleptic Gregorian
+// year, month, and day, using the algorithm described at
+// http://howardhinnant.github.io/date_algorithms.html#civil_from_days.
+// Only non-negative inputs are supported.
+func civil(days int) (year, month, day int) {
+	z := days + 719468
+	era := z / 146097
+	doe := z - era*146097
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+	doy := doe - (365*yoe + yoe/4 - yoe/100)
+	mp := (5*doy + 2) / 153
+	day = doy - (153*mp+2)/5 + 1
+	month = mp + 3
+	if month > 12 {
+		month -= 12
+	}
+	year = yoe + era*400
+	if month <= 2 {
+		year++
+	}
+	return
+}
+
+// IsZero reports whether d is the zero Date, Jan 1 1970. Since a Date is an
+// integer, a field tagged with omitempty is omitted by encoding/json and
+// encoding/xml when it is zero, as is a field tagged with omitzero. Neither
+// tag distinguishes an unset field from one set to Jan 1 1970, which is
+// nonetheless marshaled as such when untagged; use NullDate for dates which
+// may be absent.
+func (d Date) IsZero() bool {
+	return d == 0
+}
+
+// Days returns the number of days elapsed since Jan 1 1970. It is the
+// inverse of FromDays.
+func (d Date) Days() int {
+	return int(d)
+}
+
+// EpochDay returns d as an epoch-day count, with the same semantics as
+// java.time.LocalDate.toEpochDay. It is the inverse of FromEpochDay.
+func (d Date) EpochDay() int64 {
+	return int64(d)
+}
+
+// Next returns the date following d. The last representable date is its own
+// successor, so that Next never wraps around to Jan 1 1970.
+func (d Date) Next() Date {
+	if d == MaxDate {
+		return d
+	}
+	return d + 1
+}
+
+// Prev returns the date preceding d. Jan 1 1970 is its own predecessor, so
+// that Prev never wraps around to Jun 6 2149.
+func (d Date) Prev() Date {
+	if d == 0 {
+		return d
+	}
+	return d - 1
+}
+
+// JulianDay returns the Julian Day Number of the Julian day beginning at noon
+// UTC on d. It is the inverse of FromJulianDay.
+func (d Date) JulianDay() int64 {
+	return int64(d) + jdnEpoch
+}
+
+// ModifiedJulianDay returns the Modified Julian Date day number of d. It is
+// the inverse of FromModifiedJulianDay.
+func (d Date) ModifiedJulianDay() int64 {
+	return int64(d) + mjdEpoch
+}
+
+// RataDie returns the Rata Die day number of d. It is the inverse of
+// FromRataDie.
+func (d Date) RataDie() int64 {
+	return int64(d) + rdEpoch
 }
 
 // Unix returns the number of seconds elapsed since Jan 1 1970 UTC, from the
@@ -128,6 +798,18 @@ func (d Date) Unix() int64 {
 	return int64(d) * day
 }
 
+// UnixMilli is semantically identical to the Unix method, except that it
+// returns elapsed milliseconds.
+func (d Date) UnixMilli() int64 {
+	return int64(d) * day * 1e3
+}
+
+// UnixMicro is semantically identical to the Unix method, except that it
+// returns elapsed microseconds.
+func (d Date) UnixMicro() int64 {
+	return int64(d) * day * 1e6
+}
+
 // UnixNano is semantically identical to the Unix method, except that it
 // returns elapsed nanoseconds.
 func (d Date) UnixNano() int64 {
@@ -137,13 +819,30 @@ func (d Date) UnixNano() int64 {
 // Format is identical to time.Time.Format, except that any time-of-day format
 // specifiers that are used will be equivalent to "00:00:00Z".
 func (d Date) Format(layout string) string {
+	switch layout {
+	case ISOWeekDate, ISOWeekOnly:
+		return d.formatISOWeek(layout)
+	}
 	return d.UTC().Format(layout)
 }
 
+// AppendFormat is like Format but appends the textual representation to dst
+// and returns the extended buffer, mirroring time.Time.AppendFormat.
+func (d Date) AppendFormat(dst []byte, layout string) []byte {
+	switch layout {
+	case RFC3339:
+		return d.appendRFC3339(dst)
+	case ISOWeekDate, ISOWeekOnly:
+		return append(dst, d.formatISOWeek(layout)...)
+	}
+	return d.UTC().AppendFormat(dst, layout)
+}
+
 // Date is semantically identical to the behavior of t.Date(), where t is a
 // time.Time value.
 func (d Date) Date() (year int, month time.Month, day int) {
-	return d.UTC().Date()
+	year, m, day := civil(int(d))
+	return year, time.Month(m), day
 }
 
 // UTC returns a UTC Time object set to 00:00:00 on the given date.
@@ -151,6 +850,286 @@ func (d Date) UTC() time.Time {
 	return time.Unix(int64(d)*day, 0).UTC()
 }
 
+// Weekday is semantically identical to the behavior of t.Weekday(), where t
+// is a time.Time value.
+func (d Date) Weekday() time.Weekday {
+	return weekday(int(d))
+}
+
+// weekday returns the day of the week for a count of days since Jan 1 1970,
+// which was a Thursday. Negative counts are permitted.
+func weekday(days int) time.Weekday {
+	return time.Weekday((days%7 + 7 + int(time.Thursday)) % 7)
+}
+
+// StartOfWeek returns the date of the most recent day, on or before d, that
+// falls on the weekday first. Use time.Monday for ISO-8601 weeks, or
+// time.Sunday for the convention common in the US. Weeks which begin before
+// Jan 1 1970 are truncated to that date.
+func (d Date) StartOfWeek(first time.Weekday) Date {
+	n := Date((d.Weekday() - first + 7) % 7)
+	if n > d {
+		return 0
+	}
+	return d - n
+}
+
+// EndOfWeek returns the last date of the week containing d, where weeks begin
+// on the weekday first. Weeks which end after Jun 6 2149 are truncated to that
+// date.
+func (d Date) EndOfWeek(first time.Weekday) Date {
+	n := Date((first - d.Weekday() + 6) % 7)
+	if n > MaxDate-d {
+		return MaxDate
+	}
+	return d + n
+}
+
+// DiffYMD returns the calendar difference between a and b as a number of
+// whole years, months, and days, such that adding the years and months to a
+// (clamping the day to the end of the month if necessary) and then adding the
+// days yields b. This matches the behavior of dateutil's relativedelta; for
+// example, the difference from Jan 31 to Feb 28 is one month. If b precedes
+// a, all components are negative or zero.
+func DiffYMD(a, b Date) (years, months, days int) {
+	if b < a {
+		years, months, days = DiffYMD(b, a)
+		return -years, -months, -days
+	}
+	y1, m1, d1 := civil(int(a))
+	y2, m2, _ := civil(int(b))
+	n := (y2-y1)*12 + m2 - m1
+	anchor := addMonths(y1, m1, d1, n)
+	if anchor > int(b) {
+		n--
+		anchor = addMonths(y1, m1, d1, n)
+	}
+	return n / 12, n % 12, int(b) - anchor
+}
+
+// MonthsBetween returns the number of months from a to b, negative if b
+// precedes a. The whole part is the months component of DiffYMD (including
+// whole years); the fractional part is the remaining days divided by the
+// length of the month period in which they fall, measured from the preceding
+// monthly anniversary of a to the next. For example, from Jan 15 to Feb 1 is
+// 17/31 months, and from Feb 15 to Mar 1 in 2012 is 15/29 months. No rounding
+// is applied beyond that of float64 division.
+func MonthsBetween(a, b Date) float64 {
+	return between(a, b, 1)
+}
+
+// YearsBetween returns the number of years from a to b, negative if b
+// precedes a. As with MonthsBetween, the fractional part is the remaining
+// days divided by the length of the year period in which they fall, measured
+// between anniversaries of a, so it is 1/366 rather than 1/365 for a day
+// falling after a leap day.
+func YearsBetween(a, b Date) float64 {
+	return between(a, b, 12)
+}
+
+// between implements MonthsBetween and YearsBetween, where a period is the
+// given number of months.
+func between(a, b Date, period int) float64 {
+	if b < a {
+		return -between(b, a, period)
+	}
+	years, months, _ := DiffYMD(a, b)
+	n := (years*12 + months) / period
+	y, m, d := civil(int(a))
+	anchor := addMonths(y, m, d, n*period)
+	next := addMonths(y, m, d, (n+1)*period)
+	return float64(n) + float64(int(b)-anchor)/float64(next-anchor)
+}
+
+// addMonths returns the day number of the given date plus n months, with the
+// day clamped to the length of the resulting month.
+func addMonths(year, month, day, n int) int {
+	m := year*12 + month - 1 + n
+	year, month = m/12, m%12+1
+	if dim := DaysInMonth(year, time.Month(month)); day > dim {
+		day = dim
+	}
+	return fromCivil(year, month, day)
+}
+
+// YYYYMMDD returns d as an integer of the form 20120310.
+func (d Date) YYYYMMDD() int {
+	year, month, day := civil(int(d))
+	return year*10000 + month*100 + day
+}
+
+// YearDay is semantically identical to the behavior of t.YearDay(), where t
+// is a time.Time value.
+func (d Date) YearDay() int {
+	year, _, _ := d.Date()
+	return int(d) - fromCivil(year, 1, 1) + 1
+}
+
+// ISOWeek is semantically identical to the behavior of t.ISOWeek(), where t
+// is a time.Time value.
+func (d Date) ISOWeek() (year, week int) {
+	return d.Week(time.Monday, 4)
+}
+
+// Week returns the year and week number in which d occurs, for weeks which
+// begin on the weekday first, and where week 1 of a year is the first week
+// with at least minDays days in that year. Week(time.Monday, 4) is equivalent
+// to ISO-8601 week numbering; WeekRules provides regional conventions. As
+// with ISO weeks, the first or last few days of a year may belong to a week of
+// the adjacent year. minDays is clamped to the range [1,7].
+func (d Date) Week(first time.Weekday, minDays int) (year, week int) {
+	if minDays < 1 {
+		minDays = 1
+	} else if minDays > 7 {
+		minDays = 7
+	}
+	year, _, _ = d.Date()
+	start := firstWeekStart(year, first, minDays)
+	if int(d) < start {
+		year--
+		start = firstWeekStart(year, first, minDays)
+	} else if next := firstWeekStart(year+1, first, minDays); int(d) >= next {
+		year++
+		start = next
+	}
+	return year, (int(d)-start)/7 + 1
+}
+
+// WorkWeek returns the work-week year and number ("WW") in which d occurs, as
+// used in manufacturing and semiconductor supply chains: weeks begin on the
+// weekday first (commonly time.Sunday), and work week 1 is the week containing
+// Jan 1. Unlike ISO weeks, the days at the start of January therefore never
+// belong to the previous year, though the last days of December may belong
+// to week 1 of the next. The first day may be taken from WeekRules.
+func (d Date) WorkWeek(first time.Weekday) (year, ww int) {
+	return d.Week(first, 1)
+}
+
+// firstWeekStart returns the day number on which week 1 of year begins.
+func firstWeekStart(year int, first time.Weekday, minDays int) int {
+	jan1 := fromCivil(year, 1, 1)
+	offset := int(weekday(jan1)-first+7) % 7
+	if 7-offset < minDays {
+		return jan1 - offset + 7
+	}
+	return jan1 - offset
+}
+
+// IsWeekend reports whether d falls on a Saturday or Sunday.
+func (d Date) IsWeekend() bool {
+	return d.IsWeekendIn(SaturdaySunday)
+}
+
+// IsWeekday reports whether d falls on a Monday through Friday.
+func (d Date) IsWeekday() bool {
+	return d.IsWeekdayIn(SaturdaySunday)
+}
+
+// IsWeekendIn reports whether d falls on one of the days in w.
+func (d Date) IsWeekendIn(w Weekend) bool {
+	return w.Contains(d.Weekday())
+}
+
+// IsWeekdayIn reports whether d falls on a day of the week outside of w.
+func (d Date) IsWeekdayIn(w Weekend) bool {
+	return !w.Contains(d.Weekday())
+}
+
+// IsLeapYear reports whether d falls within a leap year.
+func (d Date) IsLeapYear() bool {
+	year, _, _ := d.Date()
+	return IsLeapYear(year)
+}
+
+// DaysInYear returns the number of days in d's year.
+func (d Date) DaysInYear() int {
+	year, _, _ := d.Date()
+	return DaysInYear(year)
+}
+
+// DaysInMonth returns the number of days in d's month.
+func (d Date) DaysInMonth() int {
+	year, month, _ := d.Date()
+	return DaysInMonth(year, month)
+}
+
+// Quarter returns the calendar quarter, in [1,4], in which d falls.
+func (d Date) Quarter() int {
+	_, month, _ := d.Date()
+	return int(month+2) / 3
+}
+
+// DayOfQuarter returns the 1-based ordinal day of d within its calendar
+// quarter, so that Apr 1 and Jun 30 are days 1 and 91 of the second quarter.
+func (d Date) DayOfQuarter() int {
+	return int(d-d.Truncate(Quarter)) + 1
+}
+
+// DaysInQuarter returns the number of days in d's calendar quarter: 90 or 91
+// for the first quarter, depending on leap years, 91 for the second, and 92
+// for the third and fourth.
+func (d Date) DaysInQuarter() int {
+	year, month, _ := d.Date()
+	first := (month-1)/3*3 + 1
+	return DaysInMonth(year, first) + DaysInMonth(year, first+1) + DaysInMonth(year, first+2)
+}
+
+// AgeAt returns the number of whole years elapsed between d and on, such as
+// the age on the date on of a person born on d. An anniversary is not reached
+// until its month and day have been reached, so the anniversary of Feb 29 is
+// considered to fall on Mar 1 in non-leap years. If on precedes d, AgeAt
+// returns 0.
+func (d Date) AgeAt(on Date) int {
+	if on < d {
+		return 0
+	}
+	y1, m1, d1 := d.Date()
+	y2, m2, d2 := on.Date()
+	age := y2 - y1
+	if m2 < m1 || m2 == m1 && d2 < d1 {
+		age--
+	}
+	return age
+}
+
+// StartOfYear returns the date of January 1st in d's year.
+func (d Date) StartOfYear() Date {
+	return d - Date(d.YearDay()-1)
+}
+
+// EndOfYear returns the date of December 31st in d's year. Since the last
+// representable date falls in June 2149, dates in that year will instead
+// return the last representable date.
+func (d Date) EndOfYear() Date {
+	year, _, _ := d.Date()
+	end, err := NewFromDate(year, time.December, 31)
+	if err != nil {
+		return MaxDate
+	}
+	return end
+}
+
+// Truncate returns the first date of the calendar period, given by u,
+// containing d. This is the calendar analogue of time.Time.Truncate, and as
+// with StartOfWeek, weeks which begin before Jan 1 1970 are truncated to that
+// date. Truncate panics if u is not a known Unit.
+func (d Date) Truncate(u Unit) Date {
+	switch u {
+	case Week:
+		return d.StartOfWeek(time.Monday)
+	case Month:
+		_, _, day := d.Date()
+		return d - Date(day-1)
+	case Quarter:
+		year, month, _ := d.Date()
+		start, _ := NewFromDate(year, (month-1)/3*3+1, 1)
+		return start
+	case Year:
+		return d.StartOfYear()
+	}
+	panic("epochdate: unknown Unit")
+}
+
 // Local returns a local Time object set to 00:00:00 on the given date.
 func (d Date) Local() time.Time {
 	return d.In(time.Local)
@@ -163,30 +1142,169 @@ func (d Date) In(loc *time.Location) time.Time {
 	return t.Add(time.Duration(-offset) * time.Second)
 }
 
-// MarshalText implements encoding.TextMarshaler.
+// NoonUTC returns a UTC Time object set to 12:00:00 on the given date.
+func (d Date) NoonUTC() time.Time {
+	return d.NoonIn(time.UTC)
+}
+
+// NoonIn returns a location-relative Time object set to 12:00:00 on the given
+// date. When a library needs a representative instant of a date, rather than
+// the start of that day, noon is the conventional choice: no timezone
+// observes a daylight saving transition at noon, so the result is on the
+// intended date unless the location skipped that date entirely, as
+// Pacific/Apia did on 2011-12-30, whereas midnight may be skipped or
+// repeated.
+func (d Date) NoonIn(loc *time.Location) time.Time {
+	year, month, day := d.Date()
+	return time.Date(year, month, day, 12, 0, 0, 0, loc)
+}
+
+// TextLayout is the layout used to encode and decode Dates by MarshalText,
+// AppendText, UnmarshalText, MarshalJSON, and UnmarshalJSON, and so by the
+// encoding/json and encoding/xml packages among others, and by MarshalGQL,
+// UnmarshalGQL, and NullableColumn's JSON encoding. Layouts other than the
+// default, RFC3339, are formatted and parsed as with Format and Parse.
+// Encodings with a fixed wire format, such as String, CSV, and SQL, always
+// use RFC3339. Since TextLayout is read without synchronization, it may only
+// be changed during program initialization, before any Dates are encoded or
+// decoded.
+var TextLayout = RFC3339
+
+// MarshalText implements encoding.TextMarshaler, formatting d using
+// TextLayout.
 func (d Date) MarshalText() ([]byte, error) {
-	return []byte(d.Format(RFC3339)), nil
+	return d.AppendFormat(make([]byte, 0, len(RFC3339)), TextLayout), nil
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
+// AppendText implements encoding.TextAppender, appending d to b as formatted
+// by MarshalText.
+func (d Date) AppendText(b []byte) ([]byte, error) {
+	return d.AppendFormat(b, TextLayout), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing data using
+// TextLayout.
 func (d *Date) UnmarshalText(data []byte) error {
 	var err error
-	*d, err = Parse(RFC3339, string(data))
+	*d, err = parseTextLayout(string(data))
 	return err
 }
 
-// MarshalJSON implements json.Marshaler.
+// parseTextLayout parses s using TextLayout.
+func parseTextLayout(s string) (Date, error) {
+	if TextLayout == RFC3339 {
+		return ParseDateOnly(s)
+	}
+	return Parse(TextLayout, s)
+}
+
+// MarshalJSON implements json.Marshaler, producing a JSON string formatted
+// using TextLayout.
 func (d Date) MarshalJSON() ([]byte, error) {
-	return []byte(d.Format(`"` + RFC3339 + `"`)), nil
+	b := make([]byte, 0, len(RFC3339)+2)
+	b = append(d.AppendFormat(append(b, '"'), TextLayout), '"')
+	return b, nil
 }
 
-// UnmarshalJSON implements json.Unmarshaler.
+// UnmarshalJSON implements json.Unmarshaler, parsing a JSON string using
+// TextLayout. JSON values other than strings result in a
+// *json.UnmarshalTypeError, except for null, which leaves d unchanged.
+// Malformed strings result in a *ParseError.
 func (d *Date) UnmarshalJSON(data []byte) error {
+	s, null, err := jsonString(data, reflect.TypeOf(*d))
+	if err != nil || null {
+		return err
+	}
+	v, err := parseTextLayout(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// jsonString returns the contents of data, which must be a JSON string or
+// null, for decoding into a value of type t.
+func jsonString(data []byte, t reflect.Type) (s string, null bool, err error) {
 	if bytes.Equal(data, jsonNull) {
-		return nil
+		return "", true, nil
 	}
-	data = bytes.Trim(data, `"`)
-	return d.UnmarshalText(data)
+	if len(data) == 0 || data[0] != '"' {
+		return "", false, &json.UnmarshalTypeError{Value: jsonKind(data), Type: t}
+	}
+	s, ok := unquoteJSON(data)
+	if !ok {
+		return "", false, &ParseError{"", string(data), errSyntax}
+	}
+	return s, false, nil
+}
+
+// jsonKind describes the JSON value beginning with data, as in the Value
+// field of json.UnmarshalTypeError.
+func jsonKind(data []byte) string {
+	if len(data) > 0 {
+		switch data[0] {
+		case '{':
+			return "object"
+		case '[':
+			return "array"
+		case 't', 'f':
+			return "bool"
+		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			return "number"
+		}
+	}
+	return "value"
+}
+
+// unquoteJSON returns the contents of the JSON string data, which must be a
+// single complete string token.
+func unquoteJSON(data []byte) (string, bool) {
+	if len(data) < 2 || data[len(data)-1] != '"' {
+		return "", false
+	}
+	inner := data[1 : len(data)-1]
+	if bytes.IndexByte(inner, '\\') < 0 {
+		if bytes.IndexByte(inner, '"') >= 0 {
+			return "", false
+		}
+		for _, c := range inner {
+			if c < ' ' {
+				return "", false
+			}
+		}
+		return string(inner), true
+	}
+	// Escapes are unusual in dates; leave them to encoding/json.
+	var s string
+	if json.Unmarshal(data, &s) != nil {
+		return "", false
+	}
+	return s, true
 }
 
 var jsonNull = []byte(`null`)
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding is the
+// day number as 2 bytes, big endian. Package encoding/gob uses this method,
+// so Dates in gob streams also occupy 2 bytes.
+func (d Date) MarshalBinary() ([]byte, error) {
+	return []byte{byte(d >> 8), byte(d)}, nil
+}
+
+// AppendBinary implements encoding.BinaryAppender, appending the encoding
+// produced by MarshalBinary to b.
+func (d Date) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, byte(d>>8), byte(d)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *Date) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 {
+		return errBinaryLength
+	}
+	*d = Date(data[0])<<8 | Date(data[1])
+	return nil
+}
+
+var errBinaryLength = errors.New("epochdate: binary data must be 2 bytes")
@@ -5,8 +5,15 @@
 package epochdate
 
 import (
+	"bytes"
 	"encoding"
+	"encoding/gob"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -65,6 +72,127 @@ func TestEquivalences(t *testing.T) {
 	}
 }
 
+func TestRangeError(t *testing.T) {
+	tests := []struct {
+		fn   func() (Date, error)
+		unix int64
+		date string
+	}{
+		{func() (Date, error) { return NewFromUnix(-1) }, -1, "1969-12-31"},
+		{func() (Date, error) { return NewFromDate(2200, time.January, 1) }, 7258118400, "2200-01-01"},
+		{func() (Date, error) { return FromDays(65536) }, 65536 * day, "2149-06-07"},
+		{func() (Date, error) { return FromEpochDay(-365) }, -365 * day, "1969-01-01"},
+		{func() (Date, error) { return FromYYYYMMDD(22000101) }, 7258118400, "2200-01-01"},
+		{func() (Date, error) { return Parse(RFC3339, "2200-01-01") }, 7258118400, "2200-01-01"},
+	}
+	for i, test := range tests {
+		_, err := test.fn()
+		var e *RangeError
+		if !errors.As(err, &e) || !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Expected case %d to return a *RangeError wrapping ErrOutOfRange but got %v", i, err)
+			continue
+		}
+		date := time.Date(e.Year, e.Month, e.Day, 0, 0, 0, 0, time.UTC).Format(RFC3339)
+		if e.Unix != test.unix || date != test.date {
+			t.Errorf("Expected case %d to record %d (%s) but got %d (%s)", i, test.unix, test.date, e.Unix, date)
+		}
+	}
+	const want = "epochdate: dates must be in the range [1970-01-01,2149-06-06]; got 2200-01-01"
+	if _, err := NewFromDate(2200, time.January, 1); err == nil || err.Error() != want {
+		t.Errorf("Expected error message %q but got %v", want, err)
+	}
+	huge := []struct {
+		fn   func() (Date, error)
+		days int64
+		msg  string
+	}{
+		{func() (Date, error) { return FromEpochDay(1 << 60) }, 1 << 60, "; got 1152921504606846976 days from 1970-01-01"},
+		{func() (Date, error) { return FromEpochDay(math.MinInt64) }, math.MinInt64, "; got -9223372036854775808 days from 1970-01-01"},
+		{func() (Date, error) { return FromDays(math.MaxInt) }, math.MaxInt, "; got " + strconv.Itoa(math.MaxInt) + " days from 1970-01-01"},
+		{func() (Date, error) { return FromRataDie(math.MinInt64) }, math.MinInt64, "; got -9223372036854775808 days from 1970-01-01"},
+		{func() (Date, error) { return FromEpochDay(1 << 30) }, 1 << 30, "; got 2941775-04-07"},
+	}
+	for i, test := range huge {
+		_, err := test.fn()
+		var e *RangeError
+		if !errors.As(err, &e) || e.Days != test.days || !strings.HasSuffix(e.Error(), test.msg) {
+			t.Errorf("Expected huge case %d to record %d days (%q) but got %v", i, test.days, test.msg, err)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		cause         error
+	}{
+		{RFC3339, "1969-12-31", ErrOutOfRange},
+		{RFC3339, "2012-03-1", nil},
+		{AmericanCommon, "12-31-69", ErrOutOfRange},
+		{AmericanCommon, "13-01-12", nil},
+	}
+	for _, test := range tests {
+		_, err := Parse(test.layout, test.value)
+		var e *ParseError
+		if !errors.As(err, &e) {
+			t.Errorf("Expected Parse(%q, %q) to return a *ParseError but got %v", test.layout, test.value, err)
+			continue
+		}
+		if e.Layout != test.layout || e.Value != test.value {
+			t.Errorf("Unexpected ParseError fields for Parse(%q, %q): %#v", test.layout, test.value, e)
+		}
+		if test.cause != nil && !errors.Is(err, test.cause) {
+			t.Errorf("Expected Parse(%q, %q) to wrap %v but got %v", test.layout, test.value, test.cause, err)
+		} else if test.cause == nil {
+			var te *time.ParseError
+			if !errors.As(err, &te) && test.layout != RFC3339 {
+				t.Errorf("Expected Parse(%q, %q) to wrap a *time.ParseError but got %v", test.layout, test.value, err)
+			}
+		}
+	}
+	var d Date
+	if err := d.UnmarshalText([]byte("2012-02-30")); err == nil {
+		t.Error("Expected UnmarshalText(2012-02-30) to return an error")
+	} else if _, ok := err.(*ParseError); !ok {
+		t.Error("Expected UnmarshalText to return a *ParseError but got", err)
+	}
+	const want = `epochdate: parsing "2012-03-1" as "2006-01-02": malformed value`
+	if _, err := ParseDateOnly("2012-03-1"); err == nil || err.Error() != want {
+		t.Errorf("Expected error message %q but got %v", want, err)
+	}
+}
+
+func TestDatabaseLayouts(t *testing.T) {
+	tests := []struct {
+		layout, value string
+	}{
+		{Oracle, "10-MAR-12"},
+		{Oracle, "10-Mar-12"},
+		{SQLServer, "Mar 10 2012 12:00AM"},
+		{SQLServer, "Mar 10 2012 11:59PM"},
+	}
+	for _, test := range tests {
+		d, err := Parse(test.layout, test.value)
+		if err != nil {
+			t.Errorf("Unexpected Parse(%q, %q) error: %v", test.layout, test.value, err)
+		} else if s := d.String(); s != "2012-03-10" {
+			t.Errorf("Expected Parse(%q, %q) to return 2012-03-10 but got %s", test.layout, test.value, s)
+		}
+	}
+	if d, err := Parse(SQLServer, "Mar  1 2012 12:00AM"); err != nil || d.String() != "2012-03-01" {
+		t.Error("Expected space-padded SQLServer day to parse as 2012-03-01 but got", d, err)
+	}
+	if d, err := Parse(Oracle, "02-JAN-55"); err != nil || d.String() != "2055-01-02" {
+		t.Error("Expected Parse(Oracle, \"02-JAN-55\") to return 2055-01-02 but got", d, err)
+	}
+	if _, err := ParsePivot(Oracle, "02-JAN-55", 1950); !errors.Is(err, ErrOutOfRange) {
+		t.Error("Expected ParsePivot(Oracle, \"02-JAN-55\", 1950) to return ErrOutOfRange but got", err)
+	}
+	if d, err := ParsePivot(Oracle, "02-JAN-49", 1950); err != nil || d.String() != "2049-01-02" {
+		t.Error("Expected ParsePivot(Oracle, \"02-JAN-49\", 1950) to return 2049-01-02 but got", d, err)
+	}
+}
+
 func TestExtrema(t *testing.T) {
 	var desc string
 	for _, e := range extrema {
@@ -79,6 +207,466 @@ func TestExtrema(t *testing.T) {
 	}
 }
 
+func TestFromDays(t *testing.T) {
+	tests := []struct {
+		n     int
+		valid bool
+	}{
+		{-1, false},
+		{0, true},
+		{15409, true},
+		{65535, true},
+		{65536, false},
+		{70000, false},
+	}
+	for _, test := range tests {
+		d, err := FromDays(test.n)
+		if !test.valid {
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("Expected FromDays(%d) to return ErrOutOfRange but got %v", test.n, err)
+			}
+		} else if err != nil {
+			t.Errorf("Unexpected FromDays(%d) error: %v", test.n, err)
+		} else if d.Days() != test.n {
+			t.Errorf("Expected FromDays(%d).Days() to return %d but got %d", test.n, test.n, d.Days())
+		}
+	}
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		wd    time.Weekday
+		n     int
+		want  string
+	}{
+		{2012, time.November, time.Thursday, 4, "2012-11-22"},
+		{2012, time.March, time.Saturday, 1, "2012-03-03"},
+		{2012, time.March, time.Thursday, 1, "2012-03-01"},
+		{2012, time.March, time.Thursday, 5, "2012-03-29"},
+		{2012, time.March, time.Friday, 5, "2012-03-30"},
+		{2012, time.March, time.Sunday, 5, ""},
+		{2012, time.March, time.Saturday, -1, "2012-03-31"},
+		{2012, time.March, time.Friday, -1, "2012-03-30"},
+		{2012, time.May, time.Monday, -1, "2012-05-28"},
+		{2012, time.March, time.Saturday, -5, "2012-03-03"},
+		{2012, time.March, time.Friday, -5, "2012-03-02"},
+		{2012, time.March, time.Sunday, -5, ""},
+		{2012, time.March, time.Friday, 0, ""},
+		{2012, 13, time.Friday, 1, ""},
+		{1970, time.January, time.Wednesday, 1, "1970-01-07"},
+	}
+	for _, test := range tests {
+		d, err := NthWeekdayOfMonth(test.year, test.month, test.wd, test.n)
+		if test.want == "" {
+			if err != ErrInvalidDate {
+				t.Errorf("Expected NthWeekdayOfMonth(%d, %s, %s, %d) to return ErrInvalidDate but got %v",
+					test.year, test.month, test.wd, test.n, err)
+			}
+		} else if err != nil || d.String() != test.want {
+			t.Errorf("Expected NthWeekdayOfMonth(%d, %s, %s, %d) to return %s but got %s, %v",
+				test.year, test.month, test.wd, test.n, test.want, d, err)
+		}
+	}
+	if d, err := LastWeekdayOfMonth(2012, time.February, time.Wednesday); err != nil || d.String() != "2012-02-29" {
+		t.Error("Expected LastWeekdayOfMonth(2012, February, Wednesday) to return 2012-02-29 but got", d, err)
+	}
+}
+
+func TestEpochDay(t *testing.T) {
+	// Values from java.time.LocalDate.parse(s).toEpochDay().
+	tests := []struct {
+		str string
+		n   int64
+	}{
+		{"1970-01-01", 0},
+		{"2000-01-01", 10957},
+		{"2024-03-15", 19797},
+		{"2149-06-06", 65535},
+	}
+	for _, test := range tests {
+		d, err := FromEpochDay(test.n)
+		if err != nil {
+			t.Errorf("Unexpected FromEpochDay(%d) error: %v", test.n, err)
+		} else if d.String() != test.str || d.EpochDay() != test.n {
+			t.Errorf("Expected FromEpochDay(%d) to round trip as %s but got %s, %d", test.n, test.str, d, d.EpochDay())
+		}
+	}
+	for _, n := range []int64{-1, 65536, 1 << 40} {
+		if _, err := FromEpochDay(n); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Expected FromEpochDay(%d) to return ErrOutOfRange but got %v", n, err)
+		}
+	}
+}
+
+func TestNextPrev(t *testing.T) {
+	tests := []struct {
+		d, next, prev Date
+	}{
+		{0, 1, 0},
+		{1, 2, 0},
+		{15409, 15410, 15408},
+		{65535, 65535, 65534},
+	}
+	for _, test := range tests {
+		if n := test.d.Next(); n != test.next {
+			t.Errorf("Expected Date(%d).Next() to return %d but got %d", test.d, test.next, n)
+		}
+		if p := test.d.Prev(); p != test.prev {
+			t.Errorf("Expected Date(%d).Prev() to return %d but got %d", test.d, test.prev, p)
+		}
+	}
+	// Guard against the tests running across midnight.
+	for i := 0; i < 2; i++ {
+		today, tomorrow, yesterday := Today(), Tomorrow(), Yesterday()
+		if today == Today() {
+			if tomorrow != today+1 || yesterday != today-1 {
+				t.Error("Expected Tomorrow and Yesterday to surround", today, "but got", tomorrow, yesterday)
+			}
+			break
+		}
+	}
+}
+
+func TestNow(t *testing.T) {
+	defer func(now func() time.Time) { Now = now }(Now)
+	east := time.FixedZone("east", +14*60*60)
+	Now = func() time.Time { return time.Date(2012, 3, 10, 12, 0, 0, 0, east) }
+	if d := Today(); d.String() != "2012-03-10" {
+		t.Errorf("Expected Today to return 2012-03-10 but got %s", d)
+	}
+	if d := TodayUTC(); d.String() != "2012-03-09" {
+		t.Errorf("Expected TodayUTC to return 2012-03-09 but got %s", d)
+	}
+	if d := TodayIn(time.FixedZone("west", -12*60*60)); d.String() != "2012-03-09" {
+		t.Errorf("Expected TodayIn to return 2012-03-09 but got %s", d)
+	}
+	if d := TodayIn(time.FixedZone("msk", +3*60*60)); d.String() != "2012-03-10" {
+		t.Errorf("Expected TodayIn to return 2012-03-10 but got %s", d)
+	}
+	if d, err := TodayErr(); err != nil || d.String() != "2012-03-10" {
+		t.Errorf("Expected TodayErr to return 2012-03-10 but got %s, %v", d, err)
+	}
+	if d := Tomorrow(); d.String() != "2012-03-11" {
+		t.Errorf("Expected Tomorrow to return 2012-03-11 but got %s", d)
+	}
+	if d := Yesterday(); d.String() != "2012-03-09" {
+		t.Errorf("Expected Yesterday to return 2012-03-09 but got %s", d)
+	}
+}
+
+func TestTodayErr(t *testing.T) {
+	defer func(now func() time.Time) { Now = now }(Now)
+	Now = func() time.Time { return time.Date(2149, 6, 6, 23, 0, 0, 0, time.UTC) }
+	east := time.FixedZone("east", +2*60*60)
+	if d, err := TodayUTCErr(); err != nil || d != MaxDate {
+		t.Errorf("Expected TodayUTCErr to return %s but got %s, %v", MaxDate, d, err)
+	}
+	if d, err := TodayInErr(east); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Expected TodayInErr to return ErrOutOfRange but got %s, %v", d, err)
+	}
+	if d := TodayIn(east); d != 0 {
+		t.Errorf("Expected TodayIn to return the zero Date but got %s", d)
+	}
+	Now = func() time.Time { return time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC) }
+	if d, err := TodayUTCErr(); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Expected TodayUTCErr to return ErrOutOfRange but got %s, %v", d, err)
+	}
+	if d := TodayUTC(); d != 0 {
+		t.Errorf("Expected TodayUTC to return the zero Date but got %s", d)
+	}
+}
+
+func TestFromYearDay(t *testing.T) {
+	tests := []struct {
+		year, day int
+		want      string
+		err       error
+	}{
+		{1970, 1, "1970-01-01", nil},
+		{2012, 60, "2012-02-29", nil},
+		{2011, 60, "2011-03-01", nil},
+		{2012, 366, "2012-12-31", nil},
+		{2011, 366, "", ErrInvalidDate},
+		{2011, 0, "", ErrInvalidDate},
+		{1969, 365, "", ErrOutOfRange},
+		{2149, 158, "", ErrOutOfRange},
+	}
+	for _, test := range tests {
+		d, err := FromYearDay(test.year, test.day)
+		if !errors.Is(err, test.err) {
+			t.Errorf("Expected FromYearDay(%d, %d) to return error %v but got %v", test.year, test.day, test.err, err)
+		} else if err == nil && d.String() != test.want {
+			t.Errorf("Expected FromYearDay(%d, %d) to return %s but got %s", test.year, test.day, test.want, d)
+		} else if err == nil && d.YearDay() != test.day {
+			t.Errorf("Expected %s.YearDay() to return %d but got %d", d, test.day, d.YearDay())
+		}
+	}
+}
+
+func TestParseInLocation(t *testing.T) {
+	east := time.FixedZone("EAST", +14*60*60)
+	west := time.FixedZone("west", -12*60*60)
+	tests := []struct {
+		layout, value string
+		loc           *time.Location
+		want          string
+	}{
+		{"2006-01-02 15:04", "2012-03-10 23:30", east, "2012-03-10"},
+		{"2006-01-02 15:04", "2012-03-10 00:30", west, "2012-03-10"},
+		{time.RFC3339, "2012-03-10T23:30:00Z", east, "2012-03-10"},
+		{"2006-01-02 15:04 MST", "2012-03-10 23:30 EAST", east, "2012-03-10"},
+		{RFC3339, "2012-03-10", west, "2012-03-10"},
+		{ISOWeekDate, "2012-W10-6", west, "2012-03-10"},
+	}
+	for _, test := range tests {
+		d, err := ParseInLocation(test.layout, test.value, test.loc)
+		if err != nil || d.String() != test.want {
+			t.Errorf("Expected ParseInLocation(%q, %q, %s) to return %s but got %s, %v",
+				test.layout, test.value, test.loc, test.want, d, err)
+		}
+	}
+	if _, err := ParseInLocation(RFC3339, "1969-12-31", east); !errors.Is(err, ErrOutOfRange) {
+		t.Error("Expected ParseInLocation(1969-12-31) to return ErrOutOfRange but got", err)
+	} else if _, ok := err.(*ParseError); !ok {
+		t.Error("Expected ParseInLocation to return a *ParseError but got", err)
+	}
+}
+
+func TestParseDateOnly(t *testing.T) {
+	for d := Date(0); ; d++ {
+		if rt, err := ParseDateOnly(d.String()); err != nil || rt != d {
+			t.Fatalf("Expected ParseDateOnly(%q) to return %d but got %d, %v", d.String(), d, rt, err)
+		}
+		if d == ^Date(0) {
+			break
+		}
+	}
+	invalid := []string{
+		"",
+		"2012-03-1",
+		"2012-03-100",
+		"2012/03/10",
+		"2012-0a-10",
+		"+012-03-10",
+		"2012-13-10",
+		"2012-00-10",
+		"2012-02-30",
+		"2011-02-29",
+		"2012-03-00",
+		"1969-12-31",
+		"2149-06-07",
+	}
+	for _, s := range invalid {
+		_, err := ParseDateOnly(s)
+		_, want := Parse(RFC3339, s)
+		if err == nil || want == nil {
+			t.Errorf("Expected ParseDateOnly(%q) and Parse to fail but got %v and %v", s, err, want)
+		} else if _, ok := err.(*ParseError); !ok {
+			t.Errorf("Expected ParseDateOnly(%q) to return a *ParseError but got %v", s, err)
+		} else if errors.Is(err, ErrOutOfRange) != errors.Is(want, ErrOutOfRange) {
+			t.Errorf("Expected ParseDateOnly(%q) to fail like Parse (%v) but got %v", s, want, err)
+		}
+	}
+}
+
+func BenchmarkParseDateOnly(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchDate, _ = ParseDateOnly("2012-03-10")
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchDate, _ = Parse(RFC3339, "2012-03-10")
+	}
+}
+
+func TestParseAny(t *testing.T) {
+	values := []string{
+		"2012-03-10",
+		"2012-03-10T23:59:59-12:00",
+		"20120310",
+		"2012/03/10",
+		"03/10/2012",
+		"10.03.2012",
+		"Mar 10, 2012",
+		"March 10, 2012",
+		"10 Mar 2012",
+		"10 March 2012",
+	}
+	for _, v := range values {
+		if d, err := ParseAny(v); err != nil {
+			t.Errorf("Unexpected ParseAny(%q) error: %v", v, err)
+		} else if d.String() != "2012-03-10" {
+			t.Errorf("Expected ParseAny(%q) to return 2012-03-10 but got %s", v, d)
+		}
+	}
+	if d, err := ParseAny("10/03/2012", "02/01/2006"); err != nil || d.String() != "2012-03-10" {
+		t.Error("Expected ParseAny with an explicit layout to return 2012-03-10 but got", d, err)
+	}
+	if _, err := ParseAny("1969-12-31"); !errors.Is(err, ErrOutOfRange) {
+		t.Error("Expected ParseAny(1969-12-31) to return ErrOutOfRange but got", err)
+	}
+	if _, err := ParseAny("bogus"); err == nil {
+		t.Error("Expected ParseAny(bogus) to return an error")
+	} else if e, ok := err.(*ParseError); !ok || e.Layout != "" || e.Value != "bogus" {
+		t.Errorf("Expected ParseAny(bogus) to return a *ParseError without a layout but got %#v", err)
+	}
+}
+
+func TestStrict(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		day   int
+		valid bool
+	}{
+		{2012, time.February, 29, true},
+		{2012, time.February, 30, false},
+		{2011, time.February, 29, false},
+		{2012, time.April, 31, false},
+		{2012, time.December, 31, true},
+		{2012, 13, 1, false},
+		{2012, time.January, 0, false},
+	}
+	for _, test := range tests {
+		d, err := NewFromDateStrict(test.year, test.month, test.day)
+		if test.valid {
+			if err != nil {
+				t.Errorf("Unexpected NewFromDateStrict(%d, %d, %d) error: %v", test.year, test.month, test.day, err)
+			} else if y, m, dd := d.Date(); y != test.year || m != test.month || dd != test.day {
+				t.Errorf("Expected NewFromDateStrict(%d, %d, %d) to round trip but got %s", test.year, test.month, test.day, d)
+			}
+			continue
+		} else if err != ErrInvalidDate {
+			t.Errorf("Expected NewFromDateStrict(%d, %d, %d) to return ErrInvalidDate but got %s, %v",
+				test.year, test.month, test.day, d, err)
+		}
+		value := fmt.Sprintf("%04d-%02d-%02d", test.year, test.month, test.day)
+		if d, err := Parse(RFC3339, value); err == nil {
+			t.Errorf("Expected Parse(RFC3339, %q) to return an error but got %s", value, d)
+		}
+		if d, err := ParseDateOnly(value); err == nil {
+			t.Errorf("Expected ParseDateOnly(%q) to return an error but got %s", value, d)
+		}
+	}
+	if _, err := NewFromDateStrict(1969, time.December, 31); !errors.Is(err, ErrOutOfRange) {
+		t.Error("Expected NewFromDateStrict(1969, December, 31) to return ErrOutOfRange but got", err)
+	}
+}
+
+func TestMust(t *testing.T) {
+	if d := MustParse(RFC3339, "2012-03-10"); d.String() != "2012-03-10" {
+		t.Error("Expected MustParse to return 2012-03-10 but got", d)
+	}
+	if d := MustNewFromDate(2012, time.March, 10); d.String() != "2012-03-10" {
+		t.Error("Expected MustNewFromDate to return 2012-03-10 but got", d)
+	}
+	for name, fn := range map[string]func(){
+		"MustParse":       func() { MustParse(RFC3339, "2012-03-") },
+		"MustNewFromDate": func() { MustNewFromDate(1969, time.December, 31) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected %s to panic", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
+
+func TestYYYYMMDD(t *testing.T) {
+	for d := Date(0); d < 1000; d += 7 {
+		n := d.YYYYMMDD()
+		if rt, err := FromYYYYMMDD(n); err != nil || rt != d {
+			t.Fatalf("Expected FromYYYYMMDD(%d) to return %s but got %s, %v", n, d, rt, err)
+		}
+		if s := d.Format(YYYYMMDD); s != strconv.Itoa(n) {
+			t.Fatalf("Expected %s.Format(YYYYMMDD) to return %d but got %s", d, n, s)
+		}
+	}
+	if n := MustParse(YYYYMMDD, "20120310").YYYYMMDD(); n != 20120310 {
+		t.Error("Expected 2012-03-10.YYYYMMDD() to return 20120310 but got", n)
+	}
+	tests := []struct {
+		n   int
+		err error
+	}{
+		{20120229, nil},
+		{20110229, ErrInvalidDate},
+		{20121301, ErrInvalidDate},
+		{20120100, ErrInvalidDate},
+		{2012031, ErrInvalidDate},
+		{-20120310, ErrInvalidDate},
+		{19691231, ErrOutOfRange},
+		{21490607, ErrOutOfRange},
+	}
+	for _, test := range tests {
+		if _, err := FromYYYYMMDD(test.n); !errors.Is(err, test.err) {
+			t.Errorf("Expected FromYYYYMMDD(%d) to return %v but got %v", test.n, test.err, err)
+		}
+	}
+}
+
+func TestJulianDay(t *testing.T) {
+	tests := []struct {
+		str string
+		jdn int64
+	}{
+		{"1970-01-01", 2440588},
+		{"2000-01-01", 2451545},
+		{"2012-03-10", 2455997},
+		{"2149-06-06", 2506123},
+	}
+	for _, test := range tests {
+		d, err := FromJulianDay(test.jdn)
+		if err != nil {
+			t.Errorf("Unexpected FromJulianDay(%d) error: %v", test.jdn, err)
+		} else if d.String() != test.str || d.JulianDay() != test.jdn {
+			t.Errorf("Expected FromJulianDay(%d) to round trip as %s but got %s, %d", test.jdn, test.str, d, d.JulianDay())
+		}
+	}
+	for _, jdn := range []int64{0, 2440587, 2506124} {
+		if _, err := FromJulianDay(jdn); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Expected FromJulianDay(%d) to return ErrOutOfRange but got %v", jdn, err)
+		}
+	}
+}
+
+func TestDayNumbers(t *testing.T) {
+	tests := []struct {
+		str     string
+		mjd, rd int64
+	}{
+		{"1970-01-01", 40587, 719163},
+		{"2000-01-01", 51544, 730120},
+		{"2149-06-06", 106122, 784698},
+	}
+	for _, test := range tests {
+		if d, err := FromModifiedJulianDay(test.mjd); err != nil || d.String() != test.str || d.ModifiedJulianDay() != test.mjd {
+			t.Errorf("Expected FromModifiedJulianDay(%d) to round trip as %s but got %s, %v", test.mjd, test.str, d, err)
+		}
+		if d, err := FromRataDie(test.rd); err != nil || d.String() != test.str || d.RataDie() != test.rd {
+			t.Errorf("Expected FromRataDie(%d) to round trip as %s but got %s, %v", test.rd, test.str, d, err)
+		}
+	}
+	if _, err := FromModifiedJulianDay(40586); !errors.Is(err, ErrOutOfRange) {
+		t.Error("Expected FromModifiedJulianDay(40586) to return ErrOutOfRange but got", err)
+	}
+	if _, err := FromRataDie(784699); !errors.Is(err, ErrOutOfRange) {
+		t.Error("Expected FromRataDie(784699) to return ErrOutOfRange but got", err)
+	}
+	// Rata Die 1 is Jan 1 of year 1.
+	var e *RangeError
+	if _, err := FromRataDie(1); !errors.As(err, &e) || e.Year != 1 || e.Month != time.January || e.Day != 1 {
+		t.Error("Expected FromRataDie(1) to report 0001-01-01 but got", err)
+	}
+}
+
 func TestTimezoneIrrelevance(t *testing.T) {
 	const hour = 60 * 60
 	min := time.FixedZone("min", -12*hour)
@@ -112,6 +700,20 @@ func TestDateToTime(t *testing.T) {
 	}
 }
 
+func TestNoon(t *testing.T) {
+	loc := time.FixedZone("max", +14*60*60)
+	d := Date(1)
+	if s := d.NoonUTC().Format(time.RFC3339); s != "1970-01-02T12:00:00Z" {
+		t.Error("Expected Date(1).NoonUTC() to return 1970-01-02T12:00:00Z but got", s)
+	}
+	if s := d.NoonIn(loc).Format(time.RFC3339); s != "1970-01-02T12:00:00+14:00" {
+		t.Error("Expected Date(1).NoonIn(loc) to return 1970-01-02T12:00:00+14:00 but got", s)
+	}
+	if rt, err := NewFromTime(d.NoonIn(loc)); err != nil || rt != d {
+		t.Error("Expected Date(1).NoonIn(loc) to round trip but got", rt, err)
+	}
+}
+
 func TestUnix(t *testing.T) {
 	var d Date = 1
 	const (
@@ -124,6 +726,334 @@ func TestUnix(t *testing.T) {
 	if ns := d.UnixNano(); ns != dayInNanosecs {
 		t.Error("Expected Date(1).UnixNano() to return", dayInNanosecs, "but got", ns)
 	}
+	if ms := d.UnixMilli(); ms != dayInSecs*1e3 {
+		t.Error("Expected Date(1).UnixMilli() to return", dayInSecs*1e3, "but got", ms)
+	}
+	if us := d.UnixMicro(); us != dayInSecs*1e6 {
+		t.Error("Expected Date(1).UnixMicro() to return", dayInSecs*1e6, "but got", us)
+	}
+}
+
+func TestNewFromUnixMilliMicro(t *testing.T) {
+	tests := []struct {
+		msec int64
+		want Date
+		err  bool
+	}{
+		{0, 0, false},
+		{day*1e3 - 1, 0, false},
+		{day * 1e3, 1, false},
+		{65536*day*1e3 - 1, 65535, false},
+		{65536 * day * 1e3, 0, true},
+		{-1, 0, true},
+	}
+	for _, test := range tests {
+		d, err := NewFromUnixMilli(test.msec)
+		if (err != nil) != test.err || d != test.want {
+			t.Errorf("Unexpected NewFromUnixMilli(%d) result: %d, %v", test.msec, d, err)
+		}
+		d, err = NewFromUnixMicro(test.msec * 1e3)
+		if (err != nil) != test.err || d != test.want {
+			t.Errorf("Unexpected NewFromUnixMicro(%d) result: %d, %v", test.msec*1e3, d, err)
+		}
+	}
+	if d, _ := NewFromUnixMilli(Date(15409).UnixMilli()); d != 15409 {
+		t.Error("Expected UnixMilli to round trip but got", d)
+	}
+}
+
+func TestCivil(t *testing.T) {
+	for d := Date(0); ; d++ {
+		if s, want := d.String(), d.UTC().Format(RFC3339); s != want {
+			t.Fatalf("Expected Date(%d).String() to return %s but got %s", d, want, s)
+		}
+		if d == ^Date(0) {
+			break
+		}
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	d := Date(15409)
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("msg", "d", d, "n", NullDate{d, true})
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("msg", "d", d)
+	if v := d.LogValue(); v.Kind() != slog.KindString || v.String() != "2012-03-10" {
+		t.Errorf("Expected Date(%d).LogValue() to return the string 2012-03-10 but got %v", d, v)
+	}
+	for _, want := range []string{"d=2012-03-10 n=2012-03-10\n", `"d":"2012-03-10"}`} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected log output to contain %q but got %q", want, buf.String())
+		}
+	}
+}
+
+func TestAccessorAllocs(t *testing.T) {
+	d := Date(15409)
+	buf := make([]byte, 0, 16)
+	tests := []struct {
+		name string
+		max  float64
+		fn   func()
+	}{
+		{"Date", 0, func() { d.Date() }},
+		{"Weekday", 0, func() { d.Weekday() }},
+		{"Unix", 0, func() { d.Unix() }},
+		{"String", 1, func() { _ = d.String() }},
+		{"MarshalText", 1, func() { d.MarshalText() }},
+		{"MarshalJSON", 1, func() { d.MarshalJSON() }},
+		{"AppendText", 0, func() { d.AppendText(buf[:0]) }},
+		{"AppendBinary", 0, func() { d.AppendBinary(buf[:0]) }},
+	}
+	for _, test := range tests {
+		if n := testing.AllocsPerRun(100, test.fn); n > test.max {
+			t.Errorf("Expected Date.%s to allocate at most %v times but got %v", test.name, test.max, n)
+		}
+	}
+}
+
+func TestWeekday(t *testing.T) {
+	for d := Date(0); d < 14; d++ {
+		if wd, want := d.Weekday(), d.UTC().Weekday(); wd != want {
+			t.Errorf("Expected %s.Weekday() to return %s but got %s", d, want, wd)
+		}
+	}
+}
+
+func TestWeekend(t *testing.T) {
+	// 2012-03-09 is a Friday.
+	fri, _ := Parse(RFC3339, "2012-03-09")
+	tests := []struct {
+		date    Date
+		weekend Weekend
+		want    bool
+	}{
+		{fri, SaturdaySunday, false},
+		{fri + 1, SaturdaySunday, true},
+		{fri + 2, SaturdaySunday, true},
+		{fri + 3, SaturdaySunday, false},
+		{fri, FridaySaturday, true},
+		{fri + 1, FridaySaturday, true},
+		{fri + 2, FridaySaturday, false},
+		{fri + 2, SundayOnly, true},
+		{fri, NewWeekend(time.Thursday, time.Friday), true},
+		{fri, NewWeekend(), false},
+	}
+	for _, test := range tests {
+		if b := test.date.IsWeekendIn(test.weekend); b != test.want {
+			t.Errorf("Expected %s.IsWeekendIn(%08b) to return %t but got %t", test.date, test.weekend, test.want, b)
+		}
+		if b := test.date.IsWeekdayIn(test.weekend); b == test.want {
+			t.Errorf("Expected %s.IsWeekdayIn(%08b) to return %t but got %t", test.date, test.weekend, !test.want, b)
+		}
+	}
+	if fri.IsWeekend() || !fri.IsWeekday() || !(fri + 1).IsWeekend() || (fri + 1).IsWeekday() {
+		t.Error("Unexpected IsWeekend/IsWeekday results around", fri)
+	}
+}
+
+func TestStartEndOfWeek(t *testing.T) {
+	tests := []struct {
+		date       string
+		first      time.Weekday
+		start, end string
+	}{
+		{"2012-03-10", time.Monday, "2012-03-05", "2012-03-11"},
+		{"2012-03-10", time.Sunday, "2012-03-04", "2012-03-10"},
+		{"2012-03-10", time.Saturday, "2012-03-10", "2012-03-16"},
+		{"2012-03-11", time.Monday, "2012-03-05", "2012-03-11"},
+		{"1970-01-01", time.Monday, "1970-01-01", "1970-01-04"},
+		{"2149-06-06", time.Sunday, "2149-06-01", "2149-06-06"},
+	}
+	for _, test := range tests {
+		d, _ := Parse(RFC3339, test.date)
+		if s := d.StartOfWeek(test.first).String(); s != test.start {
+			t.Errorf("Expected %s.StartOfWeek(%s) to return %s but got %s", d, test.first, test.start, s)
+		}
+		if s := d.EndOfWeek(test.first).String(); s != test.end {
+			t.Errorf("Expected %s.EndOfWeek(%s) to return %s but got %s", d, test.first, test.end, s)
+		}
+	}
+}
+
+func TestStartEndOfYear(t *testing.T) {
+	tests := []struct {
+		date, start, end string
+	}{
+		{"1970-01-01", "1970-01-01", "1970-12-31"},
+		{"2012-03-10", "2012-01-01", "2012-12-31"},
+		{"2012-12-31", "2012-01-01", "2012-12-31"},
+		{"2149-06-06", "2149-01-01", "2149-06-06"},
+	}
+	for _, test := range tests {
+		d, _ := Parse(RFC3339, test.date)
+		if s := d.StartOfYear().String(); s != test.start {
+			t.Errorf("Expected %s.StartOfYear() to return %s but got %s", d, test.start, s)
+		}
+		if s := d.EndOfYear().String(); s != test.end {
+			t.Errorf("Expected %s.EndOfYear() to return %s but got %s", d, test.end, s)
+		}
+	}
+}
+
+func TestCalendarLengths(t *testing.T) {
+	tests := []struct {
+		year      int
+		month     time.Month
+		leap      bool
+		monthDays int
+		yearDays  int
+	}{
+		{1970, time.January, false, 31, 365},
+		{1970, time.February, false, 28, 365},
+		{2000, time.February, true, 29, 366},
+		{2012, time.February, true, 29, 366},
+		{2012, time.April, true, 30, 366},
+		{2100, time.February, false, 28, 365},
+	}
+	for _, test := range tests {
+		if leap := IsLeapYear(test.year); leap != test.leap {
+			t.Errorf("Expected IsLeapYear(%d) to return %t but got %t", test.year, test.leap, leap)
+		}
+		if n := DaysInYear(test.year); n != test.yearDays {
+			t.Errorf("Expected DaysInYear(%d) to return %d but got %d", test.year, test.yearDays, n)
+		}
+		if n := DaysInMonth(test.year, test.month); n != test.monthDays {
+			t.Errorf("Expected DaysInMonth(%d, %s) to return %d but got %d", test.year, test.month, test.monthDays, n)
+		}
+		d, err := NewFromDate(test.year, test.month, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.IsLeapYear() != test.leap || d.DaysInYear() != test.yearDays || d.DaysInMonth() != test.monthDays {
+			t.Errorf("Unexpected Date method results for %s", d)
+		}
+	}
+	if n := DaysInMonth(2012, 13); n != 0 {
+		t.Error("Expected DaysInMonth(2012, 13) to return 0 but got", n)
+	}
+}
+
+func TestQuarter(t *testing.T) {
+	tests := []struct {
+		date                string
+		quarter, day, total int
+	}{
+		{"2011-01-01", 1, 1, 90},
+		{"2012-03-31", 1, 91, 91},
+		{"2012-04-01", 2, 1, 91},
+		{"2012-06-30", 2, 91, 91},
+		{"2012-08-06", 3, 37, 92},
+		{"2012-12-31", 4, 92, 92},
+	}
+	for _, test := range tests {
+		d := MustParse(RFC3339, test.date)
+		if q, day, total := d.Quarter(), d.DayOfQuarter(), d.DaysInQuarter(); q != test.quarter || day != test.day || total != test.total {
+			t.Errorf("Expected %s to be day %d of %d in Q%d but got day %d of %d in Q%d",
+				d, test.day, test.total, test.quarter, day, total, q)
+		}
+	}
+}
+
+func TestAgeAt(t *testing.T) {
+	tests := []struct {
+		birth, on string
+		age       int
+	}{
+		{"1980-05-10", "1980-05-10", 0},
+		{"1980-05-10", "2012-05-09", 31},
+		{"1980-05-10", "2012-05-10", 32},
+		{"1980-05-10", "2012-12-31", 32},
+		{"1980-02-29", "2011-02-28", 30},
+		{"1980-02-29", "2011-03-01", 31},
+		{"1980-02-29", "2012-02-29", 32},
+		{"1980-05-10", "1979-05-10", 0},
+	}
+	for _, test := range tests {
+		birth, _ := Parse(RFC3339, test.birth)
+		on, _ := Parse(RFC3339, test.on)
+		if age := birth.AgeAt(on); age != test.age {
+			t.Errorf("Expected %s.AgeAt(%s) to return %d but got %d", birth, on, test.age, age)
+		}
+	}
+}
+
+func TestDiffYMD(t *testing.T) {
+	tests := []struct {
+		a, b                string
+		years, months, days int
+	}{
+		{"2012-03-10", "2012-03-10", 0, 0, 0},
+		{"2012-03-10", "2012-03-11", 0, 0, 1},
+		{"2012-03-10", "2012-04-09", 0, 0, 30},
+		{"2012-03-10", "2012-04-10", 0, 1, 0},
+		{"2012-03-10", "2013-05-12", 1, 2, 2},
+		{"2011-01-31", "2011-02-28", 0, 1, 0},
+		{"2012-01-31", "2012-02-28", 0, 0, 28},
+		{"2012-02-29", "2013-02-28", 1, 0, 0},
+		{"2013-05-12", "2012-03-10", -1, -2, -2},
+		{"1970-01-01", "2149-06-06", 179, 5, 5},
+	}
+	for _, test := range tests {
+		a, _ := Parse(RFC3339, test.a)
+		b, _ := Parse(RFC3339, test.b)
+		if y, m, d := DiffYMD(a, b); y != test.years || m != test.months || d != test.days {
+			t.Errorf("Expected DiffYMD(%s, %s) to return %d, %d, %d but got %d, %d, %d",
+				a, b, test.years, test.months, test.days, y, m, d)
+		}
+	}
+	for d := Date(0); d < 800; d++ {
+		if n := fromCivil(civil(int(d))); n != int(d) {
+			t.Fatalf("Expected fromCivil(civil(%d)) to return %[1]d but got %d", d, n)
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		a, b          string
+		months, years float64
+	}{
+		{"2012-03-10", "2012-03-10", 0, 0},
+		{"2012-01-15", "2012-02-01", 17.0 / 31, 17.0 / 366},
+		{"2012-02-15", "2012-03-01", 15.0 / 29, 15.0 / 366},
+		{"2012-01-31", "2012-02-29", 1, 29.0 / 366},
+		{"2011-03-10", "2012-03-10", 12, 1},
+		{"2011-03-10", "2012-09-25", 18.5, 1 + 199.0/365},
+		{"2012-03-10", "2011-03-10", -12, -1},
+	}
+	for _, test := range tests {
+		a, _ := Parse(RFC3339, test.a)
+		b, _ := Parse(RFC3339, test.b)
+		if m := MonthsBetween(a, b); math.Abs(m-test.months) > 1e-12 {
+			t.Errorf("Expected MonthsBetween(%s, %s) to return %v but got %v", a, b, test.months, m)
+		}
+		if y := YearsBetween(a, b); math.Abs(y-test.years) > 1e-12 {
+			t.Errorf("Expected YearsBetween(%s, %s) to return %v but got %v", a, b, test.years, y)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		date string
+		unit Unit
+		want string
+	}{
+		{"2012-03-10", Week, "2012-03-05"},
+		{"2012-03-10", Month, "2012-03-01"},
+		{"2012-03-10", Quarter, "2012-01-01"},
+		{"2012-08-31", Quarter, "2012-07-01"},
+		{"2012-12-31", Quarter, "2012-10-01"},
+		{"2012-03-10", Year, "2012-01-01"},
+		{"1970-01-02", Week, "1970-01-01"},
+	}
+	for _, test := range tests {
+		d, _ := Parse(RFC3339, test.date)
+		if s := d.Truncate(test.unit).String(); s != test.want {
+			t.Errorf("Expected %s.Truncate(%d) to return %s but got %s", d, test.unit, test.want, s)
+		}
+	}
 }
 
 func TestEncDec(t *testing.T) {
@@ -159,6 +1089,189 @@ func TestEncDec(t *testing.T) {
 	}
 }
 
+func TestBinary(t *testing.T) {
+	tests := []struct {
+		d Date
+		b string
+	}{
+		{0, "\x00\x00"},
+		{1, "\x00\x01"},
+		{15409, "\x3c\x31"},
+		{^Date(0), "\xff\xff"},
+	}
+	for _, test := range tests {
+		b, err := test.d.MarshalBinary()
+		if err != nil || string(b) != test.b {
+			t.Errorf("Expected Date(%d).MarshalBinary() to return %q but got %q, %v", test.d, test.b, b, err)
+		}
+		var d Date
+		if err := d.UnmarshalBinary(b); err != nil || d != test.d {
+			t.Errorf("Expected UnmarshalBinary(%q) to return %d but got %d, %v", b, test.d, d, err)
+		}
+	}
+	for _, b := range []string{"", "\x01", "\x00\x01\x02"} {
+		d := Date(7)
+		if err := d.UnmarshalBinary([]byte(b)); err == nil {
+			t.Errorf("Expected UnmarshalBinary(%q) to return an error", b)
+		} else if d != 7 {
+			t.Errorf("Expected failed UnmarshalBinary(%q) to leave the date unchanged but got %d", b, d)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	type record struct {
+		Name string
+		D    Date
+		List []Date
+	}
+	in := record{"x", 15409, []Date{0, 1, ^Date(0)}}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal("Unexpected gob Encode error:", err)
+	}
+	var out record
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal("Unexpected gob Decode error:", err)
+	}
+	if out.Name != in.Name || out.D != in.D || fmt.Sprint(out.List) != fmt.Sprint(in.List) {
+		t.Errorf("Expected gob round trip to return %v but got %v", in, out)
+	}
+}
+
+func TestAppend(t *testing.T) {
+	var (
+		_ encoding.TextAppender   = Date(0)
+		_ encoding.BinaryAppender = Date(0)
+	)
+	d := Date(15409)
+	b, err := d.AppendText([]byte("d="))
+	if err != nil || string(b) != "d=2012-03-10" {
+		t.Errorf("Expected Date(%d).AppendText() to return %q but got %q, %v", d, "d=2012-03-10", b, err)
+	}
+	b, err = d.AppendBinary([]byte{0xaa})
+	if err != nil || string(b) != "\xaa\x3c\x31" {
+		t.Errorf("Expected Date(%d).AppendBinary() to return %q but got %q, %v", d, "\xaa\x3c\x31", b, err)
+	}
+}
+
+func TestTextLayout(t *testing.T) {
+	defer func(layout string) { TextLayout = layout }(TextLayout)
+	tests := []struct {
+		layout, text string
+	}{
+		{"01/02/2006", "03/10/2012"},
+		{YYYYMMDD, "20120310"},
+		{ISOWeekDate, "2012-W10-6"},
+		{RFC3339, "2012-03-10"},
+	}
+	d := Date(15409)
+	for _, test := range tests {
+		TextLayout = test.layout
+		b, err := d.MarshalText()
+		if err != nil || string(b) != test.text {
+			t.Errorf("Expected MarshalText with layout %q to return %s but got %s, %v", test.layout, test.text, b, err)
+		}
+		b, err = json.Marshal(d)
+		if err != nil || string(b) != `"`+test.text+`"` {
+			t.Errorf("Expected MarshalJSON with layout %q to return %q but got %s, %v", test.layout, test.text, b, err)
+		}
+		var got Date
+		if err := json.Unmarshal(b, &got); err != nil || got != d {
+			t.Errorf("Expected UnmarshalJSON with layout %q to return %s but got %s, %v", test.layout, d, got, err)
+		}
+		if test.layout != RFC3339 {
+			if err := got.UnmarshalText([]byte("2012-03-10")); err == nil {
+				t.Errorf("Expected UnmarshalText with layout %q to reject 2012-03-10", test.layout)
+			}
+		}
+	}
+}
+
+func TestUnmarshalJSONStrict(t *testing.T) {
+	valid := []string{`"2012-03-10"`, `"2012-03-\u0031\u0030"`}
+	for _, data := range valid {
+		var d Date
+		if err := d.UnmarshalJSON([]byte(data)); err != nil || d != 15409 {
+			t.Errorf("Expected UnmarshalJSON(%s) to return 2012-03-10 but got %s, %v", data, d, err)
+		}
+	}
+	tests := []struct {
+		data string
+		kind string // the UnmarshalTypeError Value, or empty for a *ParseError
+	}{
+		{`15409`, "number"},
+		{`-1`, "number"},
+		{`{"d":"2012-03-10"}`, "object"},
+		{`["2012-03-10"]`, "array"},
+		{`true`, "bool"},
+		{`2012-03-10`, "number"},
+		{``, "value"},
+		{`"2012-03-10`, ""},
+		{`2012-03-10"`, "number"},
+		{`""2012-03-10""`, ""},
+		{`"2012-03-10"x`, ""},
+		{`"`, ""},
+		{`"2012-03-10\"`, ""},
+		{"\"2012-03-10\t\"", ""},
+		{`""`, ""},
+		{`"2012-02-30"`, ""},
+	}
+	for _, test := range tests {
+		d := Date(7)
+		err := d.UnmarshalJSON([]byte(test.data))
+		var (
+			terr *json.UnmarshalTypeError
+			perr *ParseError
+		)
+		switch {
+		case test.kind != "" && (!errors.As(err, &terr) || terr.Value != test.kind):
+			t.Errorf("Expected UnmarshalJSON(%s) to return a *json.UnmarshalTypeError for a %s but got %v", test.data, test.kind, err)
+		case test.kind == "" && !errors.As(err, &perr):
+			t.Errorf("Expected UnmarshalJSON(%s) to return a *ParseError but got %v", test.data, err)
+		}
+		if d != 7 {
+			t.Errorf("Expected failed UnmarshalJSON(%s) to leave the date unchanged but got %s", test.data, d)
+		}
+	}
+	var v struct{ D Date }
+	err := json.Unmarshal([]byte(`{"D":15409}`), &v)
+	var terr *json.UnmarshalTypeError
+	if !errors.As(err, &terr) {
+		t.Errorf("Expected json.Unmarshal of a number to return a *json.UnmarshalTypeError but got %v", err)
+	}
+}
+
+func FuzzUnmarshalJSON(f *testing.F) {
+	for _, seed := range []string{`"2012-03-10"`, `null`, `15409`, `"2012-03-10`, `"\u0032012-03-10"`, `{}`} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var d Date
+		err := d.UnmarshalJSON(data)
+		var s string
+		if json.Unmarshal(data, &s) != nil && err == nil && string(data) != "null" {
+			t.Fatalf("UnmarshalJSON(%q) accepted a value which is not a JSON string", data)
+		}
+		if err != nil {
+			return
+		}
+		if s != "" {
+			if want, err := ParseDateOnly(s); err != nil || want != d {
+				t.Fatalf("UnmarshalJSON(%q) = %s, but the string parses as %s, %v", data, d, want, err)
+			}
+		}
+		b, err := d.MarshalJSON()
+		if err != nil {
+			t.Fatal("Unexpected MarshalJSON error:", err)
+		}
+		var round Date
+		if err := round.UnmarshalJSON(b); err != nil || round != d {
+			t.Fatalf("Round trip of %s through %s returned %s, %v", d, b, round, err)
+		}
+	})
+}
+
 func TestDate_UnmarshalJSON_null(t *testing.T) {
 	data := []byte("null")
 	input := Date(123)
@@ -175,3 +1288,182 @@ func TestDate_UnmarshalJSON_null(t *testing.T) {
 			data, input, date, want)
 	}
 }
+
+var (
+	benchDate   = Date(15409)
+	benchInt    int
+	benchString string
+)
+
+func BenchmarkString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchString = benchDate.String()
+	}
+}
+
+func BenchmarkDate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchInt, _, _ = benchDate.Date()
+	}
+}
+
+func BenchmarkWeekday(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchInt = int(benchDate.Weekday())
+	}
+}
+
+func BenchmarkUnix(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchInt = int(benchDate.Unix())
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		buf, _ := benchDate.MarshalJSON()
+		benchInt = len(buf)
+	}
+}
+
+func TestOmitZero(t *testing.T) {
+	type record struct {
+		Empty Date     `json:",omitempty"`
+		Zero  Date     `json:",omitzero"`
+		US    DateUS   `json:",omitzero"`
+		Null  NullDate `json:",omitzero"`
+		Set   Date     `json:",omitzero"`
+		Epoch NullDate `json:",omitzero"`
+	}
+	b, err := json.Marshal(record{Set: 15409, Epoch: NullDate{0, true}})
+	const want = `{"Set":"2012-03-10","Epoch":"1970-01-01"}`
+	if err != nil || string(b) != want {
+		t.Errorf("Expected record to marshal as %s but got %s, %v", want, b, err)
+	}
+	if !Date(0).IsZero() || Date(1).IsZero() {
+		t.Error("Expected only Jan 1 1970 to be the zero Date")
+	}
+}
+
+func TestBounds(t *testing.T) {
+	if MinDate.String() != "1970-01-01" || MaxDate.String() != "2149-06-06" {
+		t.Errorf("Expected bounds of 1970-01-01 and 2149-06-06 but got %s and %s", MinDate, MaxDate)
+	}
+	if !Epoch.Equal(MinDate.UTC()) {
+		t.Errorf("Expected Epoch to be %v but got %v", MinDate.UTC(), Epoch)
+	}
+	east := time.FixedZone("east", +14*60*60)
+	west := time.FixedZone("west", -12*60*60)
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{Epoch, true},
+		{Epoch.Add(-time.Nanosecond), false},
+		{Epoch.Add(-time.Hour).In(east), true},
+		{Epoch.Add(time.Hour).In(west), false},
+		{MaxDate.UTC().Add(24*time.Hour - time.Nanosecond), true},
+		{MaxDate.UTC().Add(24 * time.Hour), false},
+		{MaxDate.UTC().Add(11 * time.Hour).In(east), false},
+	}
+	for _, test := range tests {
+		if got := InRange(test.t); got != test.want {
+			t.Errorf("Expected InRange(%v) to return %v but got %v", test.t, test.want, got)
+		}
+		if _, err := NewFromTime(test.t); (err == nil) != test.want {
+			t.Errorf("Expected NewFromTime(%v) to agree with InRange but got %v", test.t, err)
+		}
+	}
+}
+
+// fuzzLayouts are the layouts exercised by FuzzParse. Those with four-digit
+// years are expected to round trip every date they parse.
+var fuzzLayouts = []struct {
+	layout    string
+	roundTrip bool
+}{
+	{RFC3339, true},
+	{AmericanShort, false},
+	{EuropeanSlash, true},
+	{YYYYMMDD, true},
+	{Oracle, false},
+	{SQLServer, true},
+	{ISOOrdinal, true},
+	{ISOWeekDate, true},
+	{ISOWeekOnly, false},
+}
+
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{"2012-03-10", "10/03/2012", "20120310", "2012-070", "2012-W10-6", "10-MAR-12", "Mar 10 2012 12:00AM"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, value string) {
+		for _, l := range fuzzLayouts {
+			d, err := Parse(l.layout, value)
+			if err != nil {
+				var perr *ParseError
+				if !errors.As(err, &perr) {
+					t.Fatalf("Parse(%q, %q) returned %T rather than a *ParseError", l.layout, value, err)
+				}
+				continue
+			}
+			if !l.roundTrip {
+				continue
+			}
+			s := d.Format(l.layout)
+			if round, err := Parse(l.layout, s); err != nil || round != d {
+				t.Fatalf("Parse(%q, %q) = %s, but its formatted form %q parses as %s, %v", l.layout, value, d, s, round, err)
+			}
+		}
+		want, werr := Parse(RFC3339, value)
+		if d, err := ParseDateOnly(value); (err == nil) != (werr == nil) || d != want {
+			t.Fatalf("ParseDateOnly(%q) = %s, %v, but Parse returned %s, %v", value, d, err, want, werr)
+		}
+	})
+}
+
+func FuzzUnmarshalText(f *testing.F) {
+	for _, seed := range []string{"2012-03-10", "", "2012-03-1", "2149-06-07", "1969-12-31"} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var d Date
+		if err := d.UnmarshalText(data); err != nil {
+			return
+		}
+		b, err := d.MarshalText()
+		if err != nil || string(b) != string(data) {
+			t.Fatalf("UnmarshalText(%q) = %s, which marshals as %q, %v", data, d, b, err)
+		}
+	})
+}
+
+func FuzzRoundTrip(f *testing.F) {
+	for _, seed := range []uint16{0, 1, 15409, 1<<16 - 1} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, days uint16) {
+		d := Date(days)
+		var text, js, bin, cbor Date
+		if b, _ := d.MarshalText(); text.UnmarshalText(b) != nil || text != d {
+			t.Errorf("Text round trip of %s through %q returned %s", d, b, text)
+		}
+		if b, _ := d.MarshalJSON(); js.UnmarshalJSON(b) != nil || js != d {
+			t.Errorf("JSON round trip of %s through %s returned %s", d, b, js)
+		}
+		if b, _ := d.MarshalBinary(); bin.UnmarshalBinary(b) != nil || bin != d {
+			t.Errorf("Binary round trip of %s through %x returned %s", d, b, bin)
+		}
+		if b, _ := d.MarshalCBOR(); cbor.UnmarshalCBOR(b) != nil || cbor != d {
+			t.Errorf("CBOR round trip of %s through %x returned %s", d, b, cbor)
+		}
+		b := d.AppendAvro(nil)
+		if v, n, err := DecodeAvro(b); err != nil || n != len(b) || v != d {
+			t.Errorf("Avro round trip of %s through %x returned %s, %d, %v", d, b, v, n, err)
+		}
+		y, m, day := d.Date()
+		if v, err := NewFromDateStrict(y, m, day); err != nil || v != d {
+			t.Errorf("NewFromDateStrict(%d, %d, %d) = %s, %v, want %s", y, m, day, v, err, d)
+		}
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package epochdatetest provides utilities for testing code which uses
+// epochdate: a fake clock for controlling the current date, fixture
+// constructors, and comparison helpers.
+package epochdatetest
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/extemporalgenome/epochdate"
+)
+
+// A Clock is a fake source of the current time, which changes only when set
+// or advanced. It is safe for concurrent use.
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewClock returns a Clock stopped at now.
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// NewClockOn returns a Clock stopped at noon on d in the local time zone, so
+// that epochdate.Today returns d wherever the test is run.
+func NewClockOn(d epochdate.Date) *Clock {
+	return NewClock(d.NoonIn(time.Local))
+}
+
+// Now returns the current time of c. It has the signature of epochdate.Now.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set stops c at now.
+func (c *Clock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+// Advance moves c forward by d, or backward if d is negative.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// AdvanceDays moves c forward by n calendar days in its location, or
+// backward if n is negative, retaining its time of day.
+func (c *Clock) AdvanceDays(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.AddDate(0, 0, n)
+}
+
+// Install replaces epochdate.Now with c.Now for the duration of the test,
+// restoring the previous function when the test and its subtests complete.
+// Since epochdate.Now is read without synchronization, Install must not be
+// called from parallel tests, nor while other goroutines may be reading the
+// current date; once installed, c may be set and advanced concurrently.
+func (c *Clock) Install(tb testing.TB) {
+	tb.Helper()
+	prev := epochdate.Now
+	epochdate.Now = c.Now
+	tb.Cleanup(func() { epochdate.Now = prev })
+}
+
+// MustDate returns the Date for s, in RFC3339 form. It panics if s is not a
+// representable date, and is intended for fixtures:
+//
+//	var launch = epochdatetest.MustDate("2024-03-15")
+func MustDate(s string) epochdate.Date {
+	return epochdate.MustParse(epochdate.RFC3339, s)
+}
+
+// MustDates is like MustDate, but returns a Date for each of s.
+func MustDates(s ...string) []epochdate.Date {
+	dates := make([]epochdate.Date, len(s))
+	for i, v := range s {
+		dates[i] = MustDate(v)
+	}
+	return dates
+}
+
+// Diff returns a description of the differences between want and got, with
+// a line for each index at which they differ, or an empty string if they are
+// equal.
+func Diff(want, got []epochdate.Date) string {
+	var b strings.Builder
+	for i := 0; i < len(want) || i < len(got); i++ {
+		switch {
+		case i >= len(got):
+			fmt.Fprintf(&b, "[%d]: missing %s\n", i, want[i])
+		case i >= len(want):
+			fmt.Fprintf(&b, "[%d]: unexpected %s\n", i, got[i])
+		case want[i] != got[i]:
+			fmt.Fprintf(&b, "[%d]: want %s, got %s (%+d days)\n", i, want[i], got[i], int(got[i])-int(want[i]))
+		}
+	}
+	return b.String()
+}
+
+// Equal reports whether got is want, reporting a test error otherwise.
+func Equal(tb testing.TB, got, want epochdate.Date) bool {
+	tb.Helper()
+	if got != want {
+		tb.Errorf("got %s, want %s (%+d days)", got, want, int(got)-int(want))
+		return false
+	}
+	return true
+}
+
+// EqualSlices reports whether got and want hold the same dates in the same
+// order, reporting a test error describing their Diff otherwise.
+func EqualSlices(tb testing.TB, got, want []epochdate.Date) bool {
+	tb.Helper()
+	if diff := Diff(want, got); diff != "" {
+		tb.Errorf("dates differ:\n%s", diff)
+		return false
+	}
+	return true
+}
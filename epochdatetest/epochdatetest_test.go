@@ -0,0 +1,109 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdatetest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/extemporalgenome/epochdate"
+)
+
+func TestClock(t *testing.T) {
+	prev := epochdate.Now
+	t.Run("install", func(t *testing.T) {
+		c := NewClockOn(MustDate("2024-03-15"))
+		c.Install(t)
+		if d := epochdate.Today(); d != MustDate("2024-03-15") {
+			t.Errorf("Expected Today to return 2024-03-15 but got %s", d)
+		}
+		c.AdvanceDays(17)
+		if d := epochdate.Today(); d != MustDate("2024-04-01") {
+			t.Errorf("Expected Today to return 2024-04-01 after AdvanceDays but got %s", d)
+		}
+		c.Advance(-13 * time.Hour)
+		if d := epochdate.Today(); d != MustDate("2024-03-31") {
+			t.Errorf("Expected Today to return 2024-03-31 after Advance but got %s", d)
+		}
+		c.Set(time.Date(2012, 3, 10, 0, 0, 0, 0, time.UTC))
+		if d := epochdate.TodayUTC(); d != 15409 {
+			t.Errorf("Expected TodayUTC to return 2012-03-10 after Set but got %s", d)
+		}
+	})
+	if reflect.ValueOf(epochdate.Now).Pointer() != reflect.ValueOf(prev).Pointer() {
+		t.Error("Expected Install to restore epochdate.Now after the test")
+	}
+}
+
+func TestNewClockOnZones(t *testing.T) {
+	defer func(loc *time.Location) { time.Local = loc }(time.Local)
+	for _, hours := range []int{-12, -5, 0, 12, 13, 14} {
+		time.Local = time.FixedZone("", hours*60*60)
+		c := NewClockOn(15409)
+		c.Install(t)
+		if d := epochdate.Today(); d != 15409 {
+			t.Errorf("Expected Today to return 2012-03-10 at UTC%+d but got %s", hours, d)
+		}
+	}
+}
+
+func TestMustDates(t *testing.T) {
+	got := MustDates("1970-01-01", "2012-03-10")
+	if len(got) != 2 || got[0] != 0 || got[1] != 15409 {
+		t.Errorf("Expected MustDates to return [1970-01-01 2012-03-10] but got %v", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected MustDate of a malformed date to panic")
+		}
+	}()
+	MustDate("2012-02-30")
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		want, got []epochdate.Date
+		diff      string
+	}{
+		{nil, nil, ""},
+		{MustDates("2012-03-10"), MustDates("2012-03-10"), ""},
+		{MustDates("2012-03-10"), MustDates("2012-03-12"), "[0]: want 2012-03-10, got 2012-03-12 (+2 days)\n"},
+		{MustDates("2012-03-10", "2012-03-11"), MustDates("2012-03-10"), "[1]: missing 2012-03-11\n"},
+		{nil, MustDates("2012-03-10"), "[0]: unexpected 2012-03-10\n"},
+	}
+	for _, test := range tests {
+		if diff := Diff(test.want, test.got); diff != test.diff {
+			t.Errorf("Expected Diff(%v, %v) to return %q but got %q", test.want, test.got, test.diff, diff)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	var tb recorder
+	if !Equal(&tb, 15409, 15409) || tb.msg != "" {
+		t.Errorf("Expected Equal dates to pass but got %q", tb.msg)
+	}
+	if Equal(&tb, 15408, 15409) || tb.msg != "got 2012-03-09, want 2012-03-10 (-1 days)" {
+		t.Errorf("Expected unequal dates to fail but got %q", tb.msg)
+	}
+	tb.msg = ""
+	if EqualSlices(&tb, MustDates("2012-03-10"), nil) || tb.msg != "dates differ:\n[0]: unexpected 2012-03-10\n" {
+		t.Errorf("Expected unequal slices to fail but got %q", tb.msg)
+	}
+}
+
+// recorder is a testing.TB which records the last error reported.
+type recorder struct {
+	testing.TB
+	msg string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.msg = fmt.Sprintf(format, args...)
+}
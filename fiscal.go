@@ -0,0 +1,137 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import "time"
+
+// A RetailPattern gives the number of weeks in each of the three periods
+// (fiscal months) of a quarter in a retail fiscal calendar.
+type RetailPattern [3]int
+
+// Common retail calendar patterns.
+var (
+	Pattern445 = RetailPattern{4, 4, 5}
+	Pattern454 = RetailPattern{4, 5, 4}
+	Pattern544 = RetailPattern{5, 4, 4}
+)
+
+// A RetailCalendar describes a 52/53-week fiscal calendar, in which every
+// fiscal year ends on the same weekday and consists of whole weeks, divided
+// into four quarters of three periods each. A year is 53 weeks long whenever
+// the end-of-year rule requires it; the extra week is added to the twelfth
+// period.
+//
+// Fiscal years are labeled by the calendar year in which they end. Under the
+// National Retail Federation calendar (a 4-5-4 year ending on the Saturday
+// nearest the end of January), this is one greater than the NRF's own label,
+// which names the year in which it begins.
+type RetailCalendar struct {
+	Pattern    RetailPattern
+	EndMonth   time.Month   // the month in or near which the fiscal year ends
+	EndWeekday time.Weekday // the weekday on which the fiscal year ends
+
+	// If Nearest is true, the fiscal year ends on the EndWeekday nearest the
+	// last day of EndMonth, which may fall early in the following month.
+	// Otherwise, it ends on the last EndWeekday within EndMonth.
+	Nearest bool
+}
+
+// NRF is the National Retail Federation 4-5-4 calendar.
+var NRF = RetailCalendar{Pattern454, time.January, time.Saturday, true}
+
+// A RetailPeriod is one of the twelve periods of a retail fiscal year.
+// Periods which begin before Jan 1 1970, or end after Jun 6 2149, have their
+// Start or End truncated to the representable range.
+type RetailPeriod struct {
+	Year    int // fiscal year, labeled by the calendar year in which it ends
+	Quarter int // fiscal quarter, in [1,4]
+	Period  int // period within the year, in [1,12]
+	Weeks   int // length of the period: 4 or 5 weeks, or 6 for a 53rd week
+	Start   Date
+	End     Date
+}
+
+// yearEnd returns the day number of the last day of fiscal year fy.
+func (c RetailCalendar) yearEnd(fy int) int {
+	last := fromCivil(fy, int(c.EndMonth), DaysInMonth(fy, c.EndMonth))
+	back := int(weekday(last)-c.EndWeekday+7) % 7
+	if c.Nearest && back > 3 {
+		return last - back + 7
+	}
+	return last - back
+}
+
+// YearOf returns the fiscal year containing d.
+func (c RetailCalendar) YearOf(d Date) int {
+	year, _, _ := d.Date()
+	if int(d) > c.yearEnd(year) {
+		return year + 1
+	} else if int(d) <= c.yearEnd(year-1) {
+		return year - 1
+	}
+	return year
+}
+
+// Weeks returns the number of weeks, 52 or 53, in fiscal year fy.
+func (c RetailCalendar) Weeks(fy int) int {
+	return (c.yearEnd(fy) - c.yearEnd(fy-1)) / 7
+}
+
+// YearBounds returns the first and last dates of fiscal year fy, truncated
+// to the representable range.
+func (c RetailCalendar) YearBounds(fy int) (start, end Date) {
+	return clampDays(c.yearEnd(fy-1) + 1), clampDays(c.yearEnd(fy))
+}
+
+// PeriodOf returns the fiscal period containing d.
+func (c RetailCalendar) PeriodOf(d Date) RetailPeriod {
+	fy := c.YearOf(d)
+	week := (int(d) - c.yearEnd(fy-1) - 1) / 7
+	p := 1
+	for ; p < 12; p++ {
+		n := c.Pattern[(p-1)%3]
+		if week < n {
+			break
+		}
+		week -= n
+	}
+	period, _ := c.Period(fy, p)
+	return period
+}
+
+// Period returns the period p, in [1,12], of fiscal year fy. ErrInvalidDate
+// is returned if p is out of range.
+func (c RetailCalendar) Period(fy, p int) (RetailPeriod, error) {
+	if p < 1 || p > 12 {
+		return RetailPeriod{}, ErrInvalidDate
+	}
+	start := c.yearEnd(fy-1) + 1
+	for i := 1; i < p; i++ {
+		start += 7 * c.Pattern[(i-1)%3]
+	}
+	weeks := c.Pattern[(p-1)%3]
+	if p == 12 && c.Weeks(fy) == 53 {
+		weeks++
+	}
+	return RetailPeriod{
+		Year:    fy,
+		Quarter: (p-1)/3 + 1,
+		Period:  p,
+		Weeks:   weeks,
+		Start:   clampDays(start),
+		End:     clampDays(start + 7*weeks - 1),
+	}, nil
+}
+
+// clampDays converts a day number to a Date, truncating it to the
+// representable range.
+func clampDays(n int) Date {
+	if n < 0 {
+		return 0
+	} else if n > int(MaxDate) {
+		return MaxDate
+	}
+	return Date(n)
+}
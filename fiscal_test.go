@@ -0,0 +1,82 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetailCalendarNRF(t *testing.T) {
+	// NRF fiscal 2012 (labeled 2013 here) began Jan 29 2012 and ended
+	// Feb 2 2013, and was a 53-week year.
+	start, end := NRF.YearBounds(2012)
+	if start.String() != "2011-01-30" || end.String() != "2012-01-28" {
+		t.Errorf("Expected NRF year 2012 to span 2011-01-30 to 2012-01-28 but got %s to %s", start, end)
+	}
+	start, end = NRF.YearBounds(2013)
+	if start.String() != "2012-01-29" || end.String() != "2013-02-02" {
+		t.Errorf("Expected NRF year 2013 to span 2012-01-29 to 2013-02-02 but got %s to %s", start, end)
+	}
+	if n := NRF.Weeks(2013); n != 53 {
+		t.Error("Expected NRF year 2013 to have 53 weeks but got", n)
+	}
+	if n := NRF.Weeks(2012); n != 52 {
+		t.Error("Expected NRF year 2012 to have 52 weeks but got", n)
+	}
+	tests := []struct {
+		date              string
+		year, period, wks int
+		pstart, pend      string
+	}{
+		{"2012-01-29", 2013, 1, 4, "2012-01-29", "2012-02-25"},
+		{"2012-03-10", 2013, 2, 5, "2012-02-26", "2012-03-31"},
+		{"2012-04-01", 2013, 3, 4, "2012-04-01", "2012-04-28"},
+		{"2013-01-01", 2013, 12, 5, "2012-12-30", "2013-02-02"},
+		{"2013-02-02", 2013, 12, 5, "2012-12-30", "2013-02-02"},
+		{"2013-02-03", 2014, 1, 4, "2013-02-03", "2013-03-02"},
+	}
+	for _, test := range tests {
+		d := MustParse(RFC3339, test.date)
+		p := NRF.PeriodOf(d)
+		if p.Year != test.year || p.Period != test.period || p.Weeks != test.wks ||
+			p.Start.String() != test.pstart || p.End.String() != test.pend {
+			t.Errorf("Unexpected NRF.PeriodOf(%s): %+v", d, p)
+		}
+		if want := (test.period-1)/3 + 1; p.Quarter != want {
+			t.Errorf("Expected NRF.PeriodOf(%s) to be in quarter %d but got %d", d, want, p.Quarter)
+		}
+	}
+}
+
+func TestRetailCalendarPeriods(t *testing.T) {
+	cal := RetailCalendar{Pattern445, time.December, time.Saturday, false}
+	for fy := 1975; fy < 2100; fy++ {
+		start, end := cal.YearBounds(fy)
+		if end.Weekday() != time.Saturday {
+			t.Fatalf("Expected fiscal year %d to end on a Saturday but got %s", fy, end.Weekday())
+		}
+		next := start
+		for p := 1; p <= 12; p++ {
+			period, err := cal.Period(fy, p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if period.Start != next || int(period.End-period.Start+1) != 7*period.Weeks {
+				t.Fatalf("Unexpected period %d of fiscal year %d: %+v", p, fy, period)
+			}
+			if cal.PeriodOf(period.Start) != period || cal.PeriodOf(period.End) != period {
+				t.Fatalf("Expected PeriodOf bounds of period %d of fiscal year %d to match", p, fy)
+			}
+			next = period.End + 1
+		}
+		if next != end+1 {
+			t.Fatalf("Expected periods of fiscal year %d to end on %s but got %s", fy, end, next-1)
+		}
+	}
+	if _, err := cal.Period(2012, 13); err != ErrInvalidDate {
+		t.Error("Expected Period(2012, 13) to return ErrInvalidDate but got", err)
+	}
+}
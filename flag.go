@@ -0,0 +1,36 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import "flag"
+
+// Set implements flag.Value, parsing s as an RFC3339 date. The special value
+// "today" denotes the current local date, as returned by Today.
+func (d *Date) Set(s string) error {
+	if s == "today" {
+		*d = Today()
+		return nil
+	}
+	v, err := ParseDateOnly(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// FlagVar defines a Date flag in fs (or flag.CommandLine, if fs is nil) with
+// the given name, default value, and usage string, returning the address of
+// a Date variable which stores the value of the flag. The flag accepts the
+// values described by Date.Set.
+func FlagVar(fs *flag.FlagSet, name string, def Date, usage string) *Date {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	d := new(Date)
+	*d = def
+	fs.Var(d, name, usage)
+	return d
+}
@@ -0,0 +1,45 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"flag"
+	"io"
+	"testing"
+)
+
+var _ flag.Value = new(Date)
+
+func TestFlagVar(t *testing.T) {
+	tests := []struct {
+		args []string
+		want Date
+		err  bool
+	}{
+		{nil, 15409, false},
+		{[]string{"-start", "2024-01-01"}, 19723, false},
+		{[]string{"-start=1970-01-02"}, 1, false},
+		{[]string{"-start", "today"}, Today(), false},
+		{[]string{"-start", "01/02/2024"}, 15409, true},
+		{[]string{"-start", "2024-02-30"}, 15409, true},
+	}
+	for _, test := range tests {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		d := FlagVar(fs, "start", 15409, "first date")
+		err := fs.Parse(test.args)
+		if (err != nil) != test.err {
+			t.Errorf("Expected Parse(%q) to return an error: %v, but got %v", test.args, test.err, err)
+		}
+		if *d != test.want {
+			t.Errorf("Expected Parse(%q) to set %s but got %s", test.args, test.want, *d)
+		}
+	}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	FlagVar(fs, "start", 15409, "first date")
+	if def := fs.Lookup("start").DefValue; def != "2012-03-10" {
+		t.Errorf("Expected the default value to print as 2012-03-10 but got %s", def)
+	}
+}
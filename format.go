@@ -0,0 +1,204 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OrdinalLong is a layout for use with Date.FormatOrdinal, producing output
+// such as "March 3rd, 2024".
+const OrdinalLong = "January 2nd, 2006"
+
+// FormatOrdinal is like Format, except that each occurrence of "2nd" in
+// layout is replaced with the day of the month followed by its English
+// ordinal suffix, such as "1st", "22nd", or "13th".
+func (d Date) FormatOrdinal(layout string) string {
+	_, _, day := d.Date()
+	parts := strings.Split(layout, "2nd")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = d.Format(p)
+		}
+	}
+	return strings.Join(parts, strconv.Itoa(day)+OrdinalSuffix(day))
+}
+
+// OrdinalSuffix returns the English ordinal suffix for n: "st", "nd", "rd",
+// or "th".
+func OrdinalSuffix(n int) string {
+	if n < 0 {
+		n = -n
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		return "th"
+	}
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// formatISOWeek implements Format for the ISOWeekDate and ISOWeekOnly layouts.
+func (d Date) formatISOWeek(layout string) string {
+	year, week := d.ISOWeek()
+	b := make([]byte, 0, len(ISOWeekDate))
+	b = append(b, byte('0'+year/1000), byte('0'+year/100%10), byte('0'+year/10%10), byte('0'+year%10),
+		'-', 'W', byte('0'+week/10), byte('0'+week%10))
+	if layout == ISOWeekDate {
+		b = append(b, '-', byte('0'+(d.Weekday()+6)%7+1))
+	}
+	return string(b)
+}
+
+// parseISOWeek implements Parse for the ISOWeekDate and ISOWeekOnly layouts.
+func parseISOWeek(layout, value string) (Date, error) {
+	if len(value) != len(layout) || value[4] != '-' || value[5] != 'W' {
+		return 0, &ParseError{layout, value, errSyntax}
+	}
+	year, ok1 := atoi(value[0:4])
+	week, ok2 := atoi(value[6:8])
+	wd, ok3 := 1, true
+	if layout == ISOWeekDate {
+		wd, ok3 = atoi(value[9:])
+		ok3 = ok3 && value[8] == '-'
+	}
+	if !ok1 || !ok2 || !ok3 {
+		return 0, &ParseError{layout, value, errSyntax}
+	} else if wd < 1 || wd > 7 {
+		return 0, &ParseError{layout, value, errWeekdayRange}
+	}
+	d, err := FromISOWeek(year, week, time.Weekday(wd%7))
+	if err == ErrInvalidDate {
+		return 0, &ParseError{layout, value, errWeekRange}
+	} else if err != nil {
+		return 0, &ParseError{layout, value, err}
+	}
+	return d, nil
+}
+
+// A FieldOrder specifies how to interpret the first two fields of a numeric
+// date such as "03/04/2012", which is Mar 4 in the US but Apr 3 in most of
+// Europe.
+type FieldOrder int
+
+// Field orders for use with ParseNumeric.
+const (
+	MonthFirst FieldOrder = iota
+	DayFirst
+)
+
+var numericLayouts = [...][]string{
+	MonthFirst: {"1/2/2006", "1.2.2006", "1-2-2006"},
+	DayFirst:   {"2/1/2006", "2.1.2006", "2-1-2006"},
+}
+
+// ParseNumeric parses a numeric date with a 4-digit year last, such as
+// "03/04/2012", "3.4.2012", or "03-04-2012", where the day and month are
+// interpreted according to order. Fields may have one or two digits, and be
+// separated by slashes, dots, or hyphens.
+func ParseNumeric(value string, order FieldOrder) (Date, error) {
+	if order != MonthFirst && order != DayFirst {
+		panic("epochdate: unknown FieldOrder")
+	}
+	return ParseAny(value, numericLayouts[order]...)
+}
+
+// IsAmbiguous reports whether value is a numeric date, as accepted by
+// ParseNumeric, which is valid but denotes different dates depending on the
+// field order, such as "03/04/2012". Values such as "13/04/2012" or
+// "04/04/2012" are unambiguous.
+func IsAmbiguous(value string) bool {
+	d1, err1 := ParseNumeric(value, MonthFirst)
+	d2, err2 := ParseNumeric(value, DayFirst)
+	return err1 == nil && err2 == nil && d1 != d2
+}
+
+// ParsePivot is like Parse, except that a two-digit year in layout (the "06"
+// element) is interpreted as the year, in the century-long window starting
+// at pivot, with those last two digits. For example, with a pivot of 1950,
+// "49" denotes 2049 and "50" denotes 1950. (Parse always uses the window
+// starting at 1969.) Any error returned is a *ParseError, which wraps
+// ErrInvalidDate if the resulting year does not contain the parsed month and
+// day, as with Feb 29 in 1900.
+func ParsePivot(layout, value string, pivot int) (Date, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return 0, &ParseError{layout, value, err}
+	}
+	var d Date
+	year, month, day := t.Date()
+	if strings.Contains(strings.Replace(layout, "2006", "", -1), "06") {
+		year = pivot - mod(pivot, 100) + mod(year, 100)
+		if year < pivot {
+			year += 100
+		}
+		if day > DaysInMonth(year, month) {
+			err = ErrInvalidDate
+		} else {
+			d, err = NewFromDate(year, month, day)
+		}
+	} else {
+		d, err = NewFromTime(t)
+	}
+	if err != nil {
+		return 0, &ParseError{layout, value, err}
+	}
+	return d, nil
+}
+
+// mod returns the non-negative remainder of a divided by b.
+func mod(a, b int) int {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
+
+// Humanize describes d relative to the date relativeTo in English, such as
+// "today", "yesterday", "tomorrow", "in 3 days", or "2 weeks ago". Differences
+// of under a week are given in days, under a month in whole weeks, under a
+// year in whole calendar months (as per DiffYMD), and otherwise in whole
+// years.
+func (d Date) Humanize(relativeTo Date) string {
+	switch int(d) - int(relativeTo) {
+	case 0:
+		return "today"
+	case -1:
+		return "yesterday"
+	case 1:
+		return "tomorrow"
+	}
+	years, months, days := DiffYMD(relativeTo, d)
+	n, unit := days, "day"
+	switch {
+	case years != 0:
+		n, unit = years, "year"
+	case months != 0:
+		n, unit = months, "month"
+	case days <= -7 || days >= 7:
+		n, unit = days/7, "week"
+	}
+	past := n < 0
+	if past {
+		n = -n
+	}
+	s := strconv.Itoa(n) + " " + unit
+	if n != 1 {
+		s += "s"
+	}
+	if past {
+		return s + " ago"
+	}
+	return "in " + s
+}
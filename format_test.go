@@ -0,0 +1,257 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestOrdinalSuffix(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "th"}, {1, "st"}, {2, "nd"}, {3, "rd"}, {4, "th"},
+		{11, "th"}, {12, "th"}, {13, "th"}, {21, "st"}, {22, "nd"},
+		{23, "rd"}, {31, "st"}, {101, "st"}, {111, "th"}, {112, "th"},
+	}
+	for _, test := range tests {
+		if s := OrdinalSuffix(test.n); s != test.want {
+			t.Errorf("Expected OrdinalSuffix(%d) to return %q but got %q", test.n, test.want, s)
+		}
+	}
+}
+
+func TestFormatOrdinal(t *testing.T) {
+	tests := []struct {
+		date, layout, want string
+	}{
+		{"2024-03-03", OrdinalLong, "March 3rd, 2024"},
+		{"2024-03-01", OrdinalLong, "March 1st, 2024"},
+		{"2024-03-12", OrdinalLong, "March 12th, 2024"},
+		{"2024-03-22", OrdinalLong, "March 22nd, 2024"},
+		{"2024-03-22", "Monday the 2nd of January", "Friday the 22nd of March"},
+		{"2024-03-22", "2nd", "22nd"},
+		{"2024-03-22", RFC3339, "2024-03-22"},
+	}
+	for _, test := range tests {
+		d := MustParse(RFC3339, test.date)
+		if s := d.FormatOrdinal(test.layout); s != test.want {
+			t.Errorf("Expected %s.FormatOrdinal(%q) to return %q but got %q", d, test.layout, test.want, s)
+		}
+	}
+}
+
+func TestISOWeekLayouts(t *testing.T) {
+	tests := []struct {
+		date, week, weekDate string
+	}{
+		{"2012-03-10", "2012-W10", "2012-W10-6"},
+		{"2012-03-05", "2012-W10", "2012-W10-1"},
+		{"2010-01-03", "2009-W53", "2009-W53-7"},
+		{"2012-12-31", "2013-W01", "2013-W01-1"},
+	}
+	for _, test := range tests {
+		d := MustParse(RFC3339, test.date)
+		if s := d.Format(ISOWeekOnly); s != test.week {
+			t.Errorf("Expected %s.Format(ISOWeekOnly) to return %s but got %s", d, test.week, s)
+		}
+		if s := d.Format(ISOWeekDate); s != test.weekDate {
+			t.Errorf("Expected %s.Format(ISOWeekDate) to return %s but got %s", d, test.weekDate, s)
+		}
+		if rt, err := Parse(ISOWeekDate, test.weekDate); err != nil || rt != d {
+			t.Errorf("Expected Parse(ISOWeekDate, %q) to return %s but got %s, %v", test.weekDate, d, rt, err)
+		}
+		if rt, err := Parse(ISOWeekOnly, test.week); err != nil || rt != d.StartOfWeek(time.Monday) {
+			t.Errorf("Expected Parse(ISOWeekOnly, %q) to return the week's Monday but got %s, %v", test.week, rt, err)
+		}
+	}
+	invalid := []struct {
+		layout, value string
+	}{
+		{ISOWeekDate, "2012-W10"},
+		{ISOWeekDate, "2012-W10-0"},
+		{ISOWeekDate, "2012-W10-8"},
+		{ISOWeekDate, "2012-W1a-1"},
+		{ISOWeekDate, "2012-X10-1"},
+		{ISOWeekDate, "2012-W10+1"},
+		{ISOWeekOnly, "2012-W00"},
+		{ISOWeekOnly, "2012-W53"},
+		{ISOWeekOnly, "2012-W10-1"},
+	}
+	for _, test := range invalid {
+		if _, err := Parse(test.layout, test.value); err == nil {
+			t.Errorf("Expected Parse(%q, %q) to return an error", test.layout, test.value)
+		} else if _, ok := err.(*ParseError); !ok {
+			t.Errorf("Expected Parse(%q, %q) to return a *ParseError but got %v", test.layout, test.value, err)
+		}
+	}
+	if _, err := Parse(ISOWeekOnly, "1970-W01"); !errors.Is(err, ErrOutOfRange) {
+		t.Error("Expected Parse(ISOWeekOnly, 1970-W01) to return ErrOutOfRange but got", err)
+	}
+}
+
+func TestISOOrdinal(t *testing.T) {
+	tests := []struct {
+		date, ordinal string
+	}{
+		{"1970-01-01", "1970-001"},
+		{"2012-03-10", "2012-070"},
+		{"2011-03-10", "2011-069"},
+		{"2012-12-31", "2012-366"},
+	}
+	for _, test := range tests {
+		d := MustParse(RFC3339, test.date)
+		if s := d.Format(ISOOrdinal); s != test.ordinal {
+			t.Errorf("Expected %s.Format(ISOOrdinal) to return %s but got %s", d, test.ordinal, s)
+		}
+		if rt, err := Parse(ISOOrdinal, test.ordinal); err != nil || rt != d {
+			t.Errorf("Expected Parse(ISOOrdinal, %q) to return %s but got %s, %v", test.ordinal, d, rt, err)
+		}
+	}
+	for _, s := range []string{"2011-366", "2012-000", "2012-70"} {
+		if _, err := Parse(ISOOrdinal, s); err == nil {
+			t.Errorf("Expected Parse(ISOOrdinal, %q) to return an error", s)
+		}
+	}
+}
+
+func TestEuropeanLayouts(t *testing.T) {
+	for layout, value := range map[string]string{EuropeanSlash: "10/03/2012", EuropeanDot: "10.03.2012"} {
+		if d, err := Parse(layout, value); err != nil || d.String() != "2012-03-10" {
+			t.Errorf("Expected Parse(%q, %q) to return 2012-03-10 but got %s, %v", layout, value, d, err)
+		} else if s := d.Format(layout); s != value {
+			t.Errorf("Expected %s.Format(%q) to return %q but got %q", d, layout, value, s)
+		}
+	}
+}
+
+func TestParseNumeric(t *testing.T) {
+	tests := []struct {
+		value                string
+		monthFirst, dayFirst string
+		ambiguous            bool
+	}{
+		{"03/04/2012", "2012-03-04", "2012-04-03", true},
+		{"3.4.2012", "2012-03-04", "2012-04-03", true},
+		{"03-04-2012", "2012-03-04", "2012-04-03", true},
+		{"04/04/2012", "2012-04-04", "2012-04-04", false},
+		{"13/04/2012", "", "2012-04-13", false},
+		{"04/13/2012", "2012-04-13", "", false},
+		{"2012-03-04", "", "", false},
+	}
+	for _, test := range tests {
+		for order, want := range map[FieldOrder]string{MonthFirst: test.monthFirst, DayFirst: test.dayFirst} {
+			d, err := ParseNumeric(test.value, order)
+			if want == "" {
+				if err == nil {
+					t.Errorf("Expected ParseNumeric(%q, %d) to return an error but got %s", test.value, order, d)
+				}
+			} else if err != nil || d.String() != want {
+				t.Errorf("Expected ParseNumeric(%q, %d) to return %s but got %s, %v", test.value, order, want, d, err)
+			}
+		}
+		if b := IsAmbiguous(test.value); b != test.ambiguous {
+			t.Errorf("Expected IsAmbiguous(%q) to return %t but got %t", test.value, test.ambiguous, b)
+		}
+	}
+}
+
+func TestParsePivot(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		pivot         int
+		want          string
+		err           error
+	}{
+		{AmericanShort, "1-2-49", 1950, "2049-01-02", nil},
+		{AmericanShort, "1-2-50", 1950, "1950-01-02", ErrOutOfRange},
+		{AmericanShort, "1-2-70", 1950, "1970-01-02", nil},
+		{AmericanShort, "1-2-69", 1950, "1969-01-02", ErrOutOfRange},
+		{AmericanShort, "1-2-69", 1970, "2069-01-02", nil},
+		{AmericanCommon, "12-31-99", 2000, "2099-12-31", nil},
+		{AmericanCommon, "02-29-00", 2000, "2000-02-29", nil},
+		{AmericanCommon, "02-29-00", 2050, "2100-02-29", ErrInvalidDate},
+		{RFC3339, "2012-03-10", 2050, "2012-03-10", nil},
+	}
+	for _, test := range tests {
+		d, err := ParsePivot(test.layout, test.value, test.pivot)
+		if !errors.Is(err, test.err) {
+			t.Errorf("Expected ParsePivot(%q, %q, %d) to return error %v but got %v",
+				test.layout, test.value, test.pivot, test.err, err)
+		} else if err == nil && d.String() != test.want {
+			t.Errorf("Expected ParsePivot(%q, %q, %d) to return %s but got %s",
+				test.layout, test.value, test.pivot, test.want, d)
+		}
+	}
+}
+
+func TestHumanize(t *testing.T) {
+	now := MustParse(RFC3339, "2012-03-10")
+	tests := []struct {
+		date, want string
+	}{
+		{"2012-03-10", "today"},
+		{"2012-03-09", "yesterday"},
+		{"2012-03-11", "tomorrow"},
+		{"2012-03-13", "in 3 days"},
+		{"2012-03-04", "6 days ago"},
+		{"2012-03-03", "1 week ago"},
+		{"2012-03-24", "in 2 weeks"},
+		{"2012-02-10", "1 month ago"},
+		{"2012-02-11", "4 weeks ago"},
+		{"2012-06-09", "in 2 months"},
+		{"2013-03-09", "in 11 months"},
+		{"2013-03-10", "in 1 year"},
+		{"2000-01-01", "12 years ago"},
+	}
+	for _, test := range tests {
+		d := MustParse(RFC3339, test.date)
+		if s := d.Humanize(now); s != test.want {
+			t.Errorf("Expected %s.Humanize(%s) to return %q but got %q", d, now, test.want, s)
+		}
+	}
+}
+
+func TestAppendFormat(t *testing.T) {
+	d := MustParse(RFC3339, "2012-03-10")
+	for _, layout := range []string{RFC3339, AmericanCommon, ISOWeekDate, ISOOrdinal, "Monday, January 2 2006"} {
+		b := d.AppendFormat([]byte("x:"), layout)
+		if want := "x:" + d.Format(layout); string(b) != want {
+			t.Errorf("Expected AppendFormat(%q) to return %q but got %q", layout, want, b)
+		}
+	}
+	buf := make([]byte, 0, 64)
+	if n := testing.AllocsPerRun(100, func() { d.AppendFormat(buf, RFC3339) }); n != 0 {
+		t.Error("Expected AppendFormat(RFC3339) not to allocate but got", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { d.AppendFormat(buf, AmericanCommon) }); n != 0 {
+		t.Error("Expected AppendFormat(AmericanCommon) not to allocate but got", n)
+	}
+}
+
+func TestFmtVerbs(t *testing.T) {
+	d := MustParse(RFC3339, "2012-03-10")
+	tests := []struct {
+		format, want string
+	}{
+		{"%v", "2012-03-10"},
+		{"%s", "2012-03-10"},
+		{"%q", `"2012-03-10"`},
+		{"%d", "15409"},
+		{"%12s|", "  2012-03-10|"},
+		{"%-12s|", "2012-03-10  |"},
+		{"%.4s", "2012"},
+		{"%8d", "   15409"},
+	}
+	for _, test := range tests {
+		if s := fmt.Sprintf(test.format, d); s != test.want {
+			t.Errorf("Expected fmt.Sprintf(%q, %s) to return %q but got %q", test.format, d, test.want, s)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gofakeitdate generates epochdate values with
+// github.com/brianvoe/gofakeit, both directly and through gofakeit's
+// function lookups, so that struct tags may populate Date fields:
+//
+//	gofakeitdate.Register()
+//	var v struct {
+//		Born epochdate.Date `fake:"{epochday:1950-01-01,2005-12-31}"`
+//		Seen string         `fake:"{epochdate:2020-01-01,2024-12-31,recent}"`
+//	}
+//	err := gofakeit.Struct(&v)
+package gofakeitdate
+
+import (
+	"fmt"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/extemporalgenome/epochdate"
+)
+
+// A Distribution determines how generated dates are spread between the
+// bounds of their range.
+type Distribution string
+
+// The supported distributions. All produce only dates within the range.
+const (
+	// Uniform makes every date in the range equally likely.
+	Uniform Distribution = "uniform"
+
+	// Recent favors later dates, with a likelihood which increases
+	// linearly from the start of the range to its end, as for the dates of
+	// activity in a growing system.
+	Recent Distribution = "recent"
+
+	// Normal favors dates near the middle of the range, approximating a
+	// normal distribution truncated to the range, as for birth dates.
+	Normal Distribution = "normal"
+)
+
+// Date returns a date from min through max inclusive, spread according to
+// dist, drawn from f, or from gofakeit's global Faker if f is nil. It panics
+// if min is after max or dist is not one of the supported distributions.
+func Date(f *gofakeit.Faker, min, max epochdate.Date, dist Distribution) epochdate.Date {
+	if min > max {
+		panic("gofakeitdate: min is after max")
+	}
+	if f == nil {
+		f = gofakeit.GlobalFaker
+	}
+	var x float64
+	switch dist {
+	case Uniform, "":
+		x = f.Float64()
+	case Recent:
+		x = max2(f.Float64(), f.Float64())
+	case Normal:
+		x = (f.Float64() + f.Float64() + f.Float64()) / 3
+	default:
+		panic(fmt.Sprintf("gofakeitdate: unknown distribution %q", dist))
+	}
+	span := float64(max-min) + 1
+	n := epochdate.Date(x * span)
+	if n > max-min {
+		n = max - min
+	}
+	return min + n
+}
+
+func max2(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Register adds two functions to gofakeit's lookups, each taking optional
+// min and max dates in RFC3339 form and a distribution, which default to the
+// entire representable range and Uniform:
+//
+//	epochdate   an epochdate.Date, formatted as "2006-01-02" in templates
+//	epochday    the day number of an epochdate.Date
+//
+// Since gofakeit populates integer fields by parsing generated text, tags on
+// epochdate.Date fields must use epochday; tags on string fields may use
+// epochdate.
+func Register() {
+	gofakeit.AddFuncLookup("epochdate", info("an epochdate.Date", "2012-03-10", "epochdate.Date", func(d epochdate.Date) any {
+		return d
+	}))
+	gofakeit.AddFuncLookup("epochday", info("the day number of an epochdate.Date", "15409", "uint16", func(d epochdate.Date) any {
+		return uint16(d)
+	}))
+}
+
+func info(desc, example, output string, result func(epochdate.Date) any) gofakeit.Info {
+	return gofakeit.Info{
+		Display:     "Epoch Date",
+		Category:    "epochdate",
+		Description: "Random date within a range, as " + desc,
+		Example:     example,
+		Output:      output,
+		Params: []gofakeit.Param{
+			{Field: "min", Display: "Min", Type: "string", Default: epochdate.MinDate.String(), Description: "First date of the range"},
+			{Field: "max", Display: "Max", Type: "string", Default: epochdate.MaxDate.String(), Description: "Last date of the range"},
+			{Field: "distribution", Display: "Distribution", Type: "string", Default: string(Uniform),
+				Options: []string{string(Uniform), string(Recent), string(Normal)}, Description: "Spread of dates within the range"},
+		},
+		Generate: func(f *gofakeit.Faker, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+			var bounds [2]epochdate.Date
+			for i, field := range [2]string{"min", "max"} {
+				s, err := info.GetString(m, field)
+				if err != nil {
+					return nil, err
+				}
+				if bounds[i], err = epochdate.ParseDateOnly(s); err != nil {
+					return nil, err
+				}
+			}
+			dist, err := info.GetString(m, "distribution")
+			if err != nil {
+				return nil, err
+			}
+			switch Distribution(dist) {
+			case Uniform, Recent, Normal:
+			default:
+				return nil, fmt.Errorf("gofakeitdate: unknown distribution %q", dist)
+			}
+			if bounds[0] > bounds[1] {
+				return nil, fmt.Errorf("gofakeitdate: min %s is after max %s", bounds[0], bounds[1])
+			}
+			return result(Date(f, bounds[0], bounds[1], Distribution(dist))), nil
+		},
+	}
+}
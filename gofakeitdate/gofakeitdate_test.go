@@ -0,0 +1,71 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gofakeitdate
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/extemporalgenome/epochdate"
+)
+
+func TestDate(t *testing.T) {
+	f := gofakeit.New(1)
+	const min, max = 15340, 15705 // 2012
+	for _, dist := range []Distribution{Uniform, Recent, Normal} {
+		var sum, late int
+		for i := 0; i < 3000; i++ {
+			d := Date(f, min, max, dist)
+			if d < min || d > max {
+				t.Fatalf("Expected %s dates within 2012 but got %s", dist, d)
+			}
+			sum += int(d - min)
+			if d > min+(max-min)*3/4 {
+				late++
+			}
+		}
+		mean := float64(sum) / 3000 / float64(max-min)
+		switch dist {
+		case Uniform, Normal:
+			if mean < 0.45 || mean > 0.55 {
+				t.Errorf("Expected %s dates centered in the range but got a mean of %.2f", dist, mean)
+			}
+		case Recent:
+			if mean < 0.6 {
+				t.Errorf("Expected %s dates to favor the end of the range but got a mean of %.2f", dist, mean)
+			}
+		}
+		if dist == Normal && late > 3000/6 {
+			t.Errorf("Expected few %s dates in the last quarter but got %d", dist, late)
+		}
+	}
+	if d := Date(f, 15409, 15409, Uniform); d != 15409 {
+		t.Errorf("Expected a single-day range to produce 2012-03-10 but got %s", d)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	Register()
+	f := gofakeit.New(1)
+	var v struct {
+		Born epochdate.Date `fake:"{epochday:2012-03-01,2012-03-31,normal}"`
+		Seen string         `fake:"{epochdate:2012-03-10,2012-03-10}"`
+		Any  epochdate.Date `fake:"{epochday}"`
+	}
+	if err := f.Struct(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Born < 15400 || v.Born > 15430 {
+		t.Errorf("Expected Born within March 2012 but got %s", v.Born)
+	}
+	if v.Seen != "2012-03-10" {
+		t.Errorf("Expected Seen to be 2012-03-10 but got %s", v.Seen)
+	}
+	for _, tag := range []string{"{epochdate:2012-03-10,2012-03-01}", "{epochdate:bogus}", "{epochdate:2012-03-01,2012-03-10,skewed}"} {
+		if s, err := f.Generate(tag); err == nil {
+			t.Errorf("Expected Generate(%q) to return an error but got %s", tag, s)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gopterdate provides generators of epochdate values for
+// property-based testing with github.com/leanovate/gopter.
+package gopterdate
+
+import (
+	"slices"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+)
+
+// Date returns a generator of Dates across the entire representable range.
+func Date() gopter.Gen {
+	return Between(epochdate.MinDate, epochdate.MaxDate)
+}
+
+// Between returns a generator of Dates from min through max inclusive. It
+// panics if min is after max.
+func Between(min, max epochdate.Date) gopter.Gen {
+	if min > max {
+		panic("gopterdate: min is after max")
+	}
+	return gen.UInt16Range(uint16(min), uint16(max)).Map(func(n uint16) epochdate.Date {
+		return epochdate.Date(n)
+	}).SuchThat(func(d epochdate.Date) bool {
+		return d >= min && d <= max
+	})
+}
+
+// NullDate returns a generator of NullDates, which are valid with dates
+// produced by g, a generator of Dates, or invalid.
+func NullDate(g gopter.Gen) gopter.Gen {
+	return gen.OneGenOf(
+		gen.Const(epochdate.NullDate{}),
+		g.Map(func(d epochdate.Date) epochdate.NullDate {
+			return epochdate.NullDate{Date: d, Valid: true}
+		}),
+	)
+}
+
+// Sorted returns a generator of ascending slices of Dates produced by g, a
+// generator of Dates, which may include duplicates.
+func Sorted(g gopter.Gen) gopter.Gen {
+	return gen.SliceOf(g).Map(func(dates []epochdate.Date) []epochdate.Date {
+		slices.Sort(dates)
+		return dates
+	})
+}
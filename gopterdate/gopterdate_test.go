@@ -0,0 +1,32 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gopterdate
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestGenerators(t *testing.T) {
+	properties := gopter.NewProperties(nil)
+	properties.Property("Between", prop.ForAll(func(d epochdate.Date) bool {
+		return d >= 15409 && d <= 15705
+	}, Between(15409, 15705)))
+	properties.Property("Date", prop.ForAll(func(d epochdate.Date) bool {
+		v, err := epochdate.Parse(epochdate.RFC3339, d.String())
+		return err == nil && v == d
+	}, Date()))
+	properties.Property("NullDate", prop.ForAll(func(n epochdate.NullDate) bool {
+		return !n.Valid && n.Date == 0 || n.Valid && n.Date == 15409
+	}, NullDate(Between(15409, 15409))))
+	properties.Property("Sorted", prop.ForAll(func(dates []epochdate.Date) bool {
+		return slices.IsSorted(dates)
+	}, Sorted(Date())))
+	properties.TestingRun(t)
+}
@@ -0,0 +1,34 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"fmt"
+	"io"
+)
+
+// MarshalGQL writes d to w as a GraphQL string formatted using TextLayout, as
+// for MarshalJSON. Together with UnmarshalGQL, it allows Date to be bound as a
+// custom scalar by github.com/99designs/gqlgen without an adapter.
+func (d Date) MarshalGQL(w io.Writer) {
+	b, _ := d.MarshalJSON()
+	w.Write(b)
+}
+
+// UnmarshalGQL sets d from a GraphQL input value, which must be a string
+// holding a date formatted using TextLayout. The error for input of any other
+// type names that type, and the error for malformed strings is a *ParseError.
+func (d *Date) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("epochdate: GraphQL Date must be a string, not %T", v)
+	}
+	date, err := parseTextLayout(s)
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
+}
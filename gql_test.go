@@ -0,0 +1,63 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMarshalGQL(t *testing.T) {
+	var b strings.Builder
+	Date(15409).MarshalGQL(&b)
+	if want := `"2012-03-10"`; b.String() != want {
+		t.Errorf("Expected MarshalGQL to write %s but got %s", want, b.String())
+	}
+}
+
+func TestUnmarshalGQL(t *testing.T) {
+	var d Date
+	if err := d.UnmarshalGQL("2012-03-10"); err != nil || d != 15409 {
+		t.Errorf("Expected UnmarshalGQL(2012-03-10) to return 15409 but got %d, %v", d, err)
+	}
+	tests := []struct {
+		v    interface{}
+		want string
+	}{
+		{15409, "not int"},
+		{nil, "not <nil>"},
+		{"2012-13-10", "month out of range"},
+		{"March 10", "malformed"},
+	}
+	for _, test := range tests {
+		d := Date(7)
+		err := d.UnmarshalGQL(test.v)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("Expected UnmarshalGQL(%#v) to return an error containing %q but got %v", test.v, test.want, err)
+		}
+		if d != 7 {
+			t.Errorf("Expected failed UnmarshalGQL(%#v) to leave the date unchanged but got %d", test.v, d)
+		}
+	}
+	var perr *ParseError
+	if err := d.UnmarshalGQL("bogus"); !errors.As(err, &perr) {
+		t.Errorf("Expected UnmarshalGQL(bogus) to return a *ParseError but got %v", err)
+	}
+}
+
+func TestGQLTextLayout(t *testing.T) {
+	defer func(layout string) { TextLayout = layout }(TextLayout)
+	TextLayout = YYYYMMDD
+	var b strings.Builder
+	Date(15409).MarshalGQL(&b)
+	if want := `"20120310"`; b.String() != want {
+		t.Errorf("Expected MarshalGQL to write %s but got %s", want, b.String())
+	}
+	var d Date
+	if err := d.UnmarshalGQL(strings.Trim(b.String(), `"`)); err != nil || d != 15409 {
+		t.Errorf("Expected UnmarshalGQL to round trip %s but got %s, %v", b.String(), d, err)
+	}
+}
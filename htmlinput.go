@@ -0,0 +1,79 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"html/template"
+	"reflect"
+)
+
+// The value of an HTML <input type="date"> element is always submitted and
+// set in RFC3339 (yyyy-mm-dd) form, regardless of how the browser displays
+// it, and is empty when the user has not chosen a date. The helpers below
+// apply that wire format irrespective of TextLayout.
+
+// ParseInputValue parses the submitted value of a date input. An empty value
+// yields an invalid NullDate rather than an error, so that optional fields
+// may be left blank.
+func ParseInputValue(value string) (NullDate, error) {
+	if value == "" {
+		return NullDate{}, nil
+	}
+	d, err := ParseDateOnly(value)
+	if err != nil {
+		return NullDate{}, err
+	}
+	return NullDate{d, true}, nil
+}
+
+// InputValue returns d in the form used for the value attribute of a date
+// input.
+func (d Date) InputValue() string {
+	return d.String()
+}
+
+// InputValue returns the value attribute for a date input: empty if n is
+// invalid, so that the input is rendered blank.
+func (n NullDate) InputValue() string {
+	if !n.Valid {
+		return ""
+	}
+	return n.Date.String()
+}
+
+// InputRange returns the min and max attributes constraining a date input to
+// the dates from min through max inclusive, for use within an element in
+// html/template:
+//
+//	<input type="date" name="due" {{.DueBounds}}>
+func InputRange(min, max Date) template.HTMLAttr {
+	return template.HTMLAttr(`min="` + min.String() + `" max="` + max.String() + `"`)
+}
+
+// FormConverter converts a submitted date input value to a Date. Its
+// signature matches the Converter type of github.com/gorilla/schema, so that
+// Date fields may be decoded from forms after registering it:
+//
+//	decoder.RegisterConverter(epochdate.Date(0), epochdate.FormConverter)
+//
+// Following that convention, the result is the zero reflect.Value if value
+// is not a valid date.
+func FormConverter(value string) reflect.Value {
+	d, err := ParseDateOnly(value)
+	if err != nil {
+		return reflect.Value{}
+	}
+	return reflect.ValueOf(d)
+}
+
+// NullFormConverter is like FormConverter, but for NullDate fields, which
+// are left invalid when the input is blank.
+func NullFormConverter(value string) reflect.Value {
+	n, err := ParseInputValue(value)
+	if err != nil {
+		return reflect.Value{}
+	}
+	return reflect.ValueOf(n)
+}
@@ -0,0 +1,61 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+)
+
+func TestParseInputValue(t *testing.T) {
+	tests := []struct {
+		value string
+		want  NullDate
+		ok    bool
+	}{
+		{"", NullDate{}, true},
+		{"2012-03-10", NullDate{15409, true}, true},
+		{"1970-01-01", NullDate{0, true}, true},
+		{"03/10/2012", NullDate{}, false},
+		{"2012-02-30", NullDate{}, false},
+	}
+	for _, test := range tests {
+		n, err := ParseInputValue(test.value)
+		if (err == nil) != test.ok || n != test.want {
+			t.Errorf("Expected ParseInputValue(%q) to return %v, %v but got %v, %v", test.value, test.want, test.ok, n, err)
+		}
+		if test.ok && n.InputValue() != test.value {
+			t.Errorf("Expected %v.InputValue() to return %q but got %q", n, test.value, n.InputValue())
+		}
+	}
+}
+
+func TestInputRange(t *testing.T) {
+	const want = `<input type="date" min="2012-03-10" max="2012-12-31">`
+	tmpl := template.Must(template.New("").Parse(`<input type="date" {{.}}>`))
+	var b strings.Builder
+	if err := tmpl.Execute(&b, InputRange(15409, 15705)); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != want {
+		t.Errorf("Expected InputRange to render %s but got %s", want, b.String())
+	}
+}
+
+func TestFormConverter(t *testing.T) {
+	if v := FormConverter("2012-03-10"); !v.IsValid() || v.Interface() != Date(15409) {
+		t.Errorf("Expected FormConverter to return 2012-03-10 but got %v", v)
+	}
+	if v := FormConverter(""); v.IsValid() {
+		t.Errorf("Expected FormConverter of an empty value to return an invalid Value but got %v", v)
+	}
+	if v := NullFormConverter(""); !v.IsValid() || v.Interface() != (NullDate{}) {
+		t.Errorf("Expected NullFormConverter of an empty value to return NULL but got %v", v)
+	}
+	if v := NullFormConverter("bogus"); v.IsValid() {
+		t.Errorf("Expected NullFormConverter of a bad value to return an invalid Value but got %v", v)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package locale formats epochdate values with localized month and weekday
+// names, using golang.org/x/text for language tag matching.
+//
+// Layouts are the same reference layouts used by epochdate.Date.Format. The
+// English month and weekday elements ("January", "Jan", "Monday", and "Mon")
+// are replaced with their equivalents in the matched language; all other
+// elements are formatted as usual.
+package locale
+
+import (
+	"strings"
+
+	"github.com/extemporalgenome/epochdate"
+	"golang.org/x/text/language"
+)
+
+type names struct {
+	long                    string // conventional long date layout
+	months, shortMonths     [12]string
+	weekdays, shortWeekdays [7]string
+}
+
+var supported = []language.Tag{
+	language.English,
+	language.Spanish,
+	language.French,
+	language.German,
+	language.Italian,
+	language.Portuguese,
+	language.Dutch,
+}
+
+var matcher = language.NewMatcher(supported)
+
+// tables correspond, by index, to supported.
+var tables = []names{
+	{
+		"January 2, 2006",
+		[12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		[12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		[7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		[7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	},
+	{
+		"2 de January de 2006",
+		[12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		[12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		[7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		[7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	},
+	{
+		"2 January 2006",
+		[12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		[12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		[7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		[7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	},
+	{
+		"2. January 2006",
+		[12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		[12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		[7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		[7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+	},
+	{
+		"2 January 2006",
+		[12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		[12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		[7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		[7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+	},
+	{
+		"2 de January de 2006",
+		[12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		[12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		[7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		[7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+	},
+	{
+		"2 January 2006",
+		[12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		[12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		[7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		[7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+	},
+}
+
+// lookup returns the names for the supported language best matching tag,
+// falling back to English.
+func lookup(tag language.Tag) *names {
+	_, i, _ := matcher.Match(tag)
+	return &tables[i]
+}
+
+// LongLayout returns the conventional long date layout for the language
+// best matching tag, such as "2 de January de 2006" for Spanish, for use with
+// Format.
+func LongLayout(tag language.Tag) string {
+	return lookup(tag).long
+}
+
+// Format returns d formatted according to layout, with month and weekday
+// names in the language best matching tag. English is used if no supported
+// language matches. For example, formatting Mar 3 2024 using the Spanish
+// LongLayout yields "3 de marzo de 2024".
+func Format(d epochdate.Date, tag language.Tag, layout string) string {
+	n := lookup(tag)
+	_, month, _ := d.Date()
+	wd := d.Weekday()
+	var b strings.Builder
+	start := 0 // start of the pending run of non-name layout elements
+	for i := 0; i < len(layout); {
+		var name string
+		var width int
+		switch rest := layout[i:]; {
+		case strings.HasPrefix(rest, "January"):
+			name, width = n.months[month-1], len("January")
+		case strings.HasPrefix(rest, "Jan"):
+			name, width = n.shortMonths[month-1], len("Jan")
+		case strings.HasPrefix(rest, "Monday"):
+			name, width = n.weekdays[wd], len("Monday")
+		case strings.HasPrefix(rest, "Mon"):
+			name, width = n.shortWeekdays[wd], len("Mon")
+		default:
+			i++
+			continue
+		}
+		b.WriteString(d.Format(layout[start:i]))
+		b.WriteString(name)
+		i += width
+		start = i
+	}
+	b.WriteString(d.Format(layout[start:]))
+	return b.String()
+}
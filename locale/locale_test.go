@@ -0,0 +1,45 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package locale
+
+import (
+	"testing"
+
+	"github.com/extemporalgenome/epochdate"
+	"golang.org/x/text/language"
+)
+
+func TestFormat(t *testing.T) {
+	d := epochdate.MustParse(epochdate.RFC3339, "2024-03-03")
+	tests := []struct {
+		tag    string
+		layout string
+		want   string
+	}{
+		{"en", "", "March 3, 2024"},
+		{"es", "", "3 de marzo de 2024"},
+		{"es-MX", "", "3 de marzo de 2024"},
+		{"fr-CA", "", "3 mars 2024"},
+		{"de", "", "3. März 2024"},
+		{"it", "", "3 marzo 2024"},
+		{"pt-BR", "", "3 de março de 2024"},
+		{"nl", "", "3 maart 2024"},
+		{"ja", "", "March 3, 2024"},
+		{"de", "Monday, 2. January 2006", "Sonntag, 3. März 2024"},
+		{"fr", "Mon 2 Jan 2006", "dim. 3 mars 2024"},
+		{"es", "Mon, 02/01/2006", "dom, 03/03/2024"},
+		{"es", epochdate.RFC3339, "2024-03-03"},
+	}
+	for _, test := range tests {
+		tag := language.MustParse(test.tag)
+		layout := test.layout
+		if layout == "" {
+			layout = LongLayout(tag)
+		}
+		if s := Format(d, tag, layout); s != test.want {
+			t.Errorf("Expected Format(%s, %s, %q) to return %q but got %q", d, tag, layout, test.want, s)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package msgpackdate registers epochdate.Date as a MessagePack extension
+// type with github.com/vmihailenco/msgpack, so that dates are encoded in 4
+// bytes (a fixext 2 header and the big-endian day number produced by
+// Date.MarshalBinary), rather than as strings.
+package msgpackdate
+
+import (
+	"reflect"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// ExtID is the extension type used by Register. It matches the CBOR tag for
+// days since the epoch (RFC 8943). Applications which already use this type
+// for other values should call RegisterExt with another type instead.
+const ExtID int8 = 100
+
+// Register registers epochdate.Date with the msgpack package using ExtID.
+// It should be called once, during initialization, by programs on both ends
+// of the connection.
+func Register() {
+	RegisterExt(ExtID)
+}
+
+// RegisterExt registers epochdate.Date with the msgpack package using the
+// application-defined extension type id, which must be in [0,127].
+func RegisterExt(id int8) {
+	msgpack.RegisterExtEncoder(id, epochdate.Date(0), encode)
+	msgpack.RegisterExtDecoder(id, epochdate.Date(0), decode)
+}
+
+func encode(_ *msgpack.Encoder, v reflect.Value) ([]byte, error) {
+	return epochdate.Date(v.Uint()).MarshalBinary()
+}
+
+func decode(dec *msgpack.Decoder, v reflect.Value, extLen int) error {
+	b := make([]byte, extLen)
+	if err := dec.ReadFull(b); err != nil {
+		return err
+	}
+	var d epochdate.Date
+	if err := d.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	v.SetUint(uint64(d))
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package msgpackdate
+
+import (
+	"testing"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func init() {
+	Register()
+}
+
+func TestRoundTrip(t *testing.T) {
+	type record struct {
+		D    epochdate.Date
+		P    *epochdate.Date
+		List []epochdate.Date
+	}
+	p := epochdate.Date(1)
+	in := record{D: 15409, P: &p, List: []epochdate.Date{0, ^epochdate.Date(0)}}
+	b, err := msgpack.Marshal(in)
+	if err != nil {
+		t.Fatal("Unexpected Marshal error:", err)
+	}
+	var out record
+	if err := msgpack.Unmarshal(b, &out); err != nil {
+		t.Fatal("Unexpected Unmarshal error:", err)
+	}
+	if out.D != in.D || out.P == nil || *out.P != *in.P || len(out.List) != 2 || out.List[0] != 0 || out.List[1] != ^epochdate.Date(0) {
+		t.Errorf("Expected round trip to return %v but got %v", in, out)
+	}
+}
+
+func TestEncoding(t *testing.T) {
+	b, err := msgpack.Marshal(epochdate.Date(15409))
+	if want := "\xd5\x64\x3c\x31"; err != nil || string(b) != want {
+		t.Errorf("Expected Marshal(Date(15409)) to return %x but got %x, %v", want, b, err)
+	}
+	var d epochdate.Date
+	if err := msgpack.Unmarshal([]byte("\xd4\x64\x3c"), &d); err == nil {
+		t.Error("Expected Unmarshal of a 1-byte extension to return an error")
+	}
+	if err := msgpack.Unmarshal([]byte("\xd5\x65\x3c\x31"), &d); err == nil {
+		t.Error("Expected Unmarshal of another extension type to return an error")
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// NullDate represents a Date which may be absent, such as a nullable DATE
+// column or an optional JSON field. Since the zero Date is itself a valid
+// date (Jan 1 1970), Valid distinguishes the absence of a date. NullDate
+// mirrors sql.NullTime.
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// Scan implements sql.Scanner, setting Valid to false for NULL values and for
+// MySQL zero dates ("0000-00-00"), which MySQL uses in place of NULL for
+// absent dates in some configurations.
+func (n *NullDate) Scan(src interface{}) error {
+	if src == nil || isZeroDate(src) {
+		n.Date, n.Valid = 0, false
+		return nil
+	}
+	n.Valid = true
+	return n.Date.Scan(src)
+}
+
+// Value implements driver.Valuer, producing NULL if n is not valid.
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.Value()
+}
+
+// IsZero reports whether n is not valid, so that absent dates are omitted from
+// encoding/json output for fields tagged with omitzero. A valid NullDate on
+// Jan 1 1970 is not zero.
+func (n NullDate) IsZero() bool {
+	return !n.Valid
+}
+
+// String returns the RFC3339 form of the date, or "NULL" if n is not valid.
+func (n NullDate) String() string {
+	if !n.Valid {
+		return "NULL"
+	}
+	return n.Date.String()
+}
+
+// MarshalText implements encoding.TextMarshaler, producing empty text if n is
+// not valid.
+func (n NullDate) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Date.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text results in an
+// invalid NullDate.
+func (n *NullDate) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		n.Date, n.Valid = 0, false
+		return nil
+	}
+	if err := n.Date.UnmarshalText(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, producing null if n is not valid.
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Date.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null results in an
+// invalid NullDate.
+func (n *NullDate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		n.Date, n.Valid = 0, false
+		return nil
+	}
+	if err := n.Date.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	_ sql.Scanner              = new(NullDate)
+	_ driver.Valuer            = NullDate{}
+	_ encoding.TextMarshaler   = NullDate{}
+	_ encoding.TextUnmarshaler = new(NullDate)
+	_ json.Marshaler           = NullDate{}
+	_ json.Unmarshaler         = new(NullDate)
+)
+
+func TestNullDateSQL(t *testing.T) {
+	n := NullDate{Date: 42, Valid: true}
+	if err := n.Scan(nil); err != nil || n.Valid || n.Date != 0 {
+		t.Error("Expected Scan(nil) to produce an invalid NullDate but got", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Error("Expected an invalid NullDate to have a nil Value but got", v, err)
+	}
+	if err := n.Scan("2012-03-10"); err != nil || !n.Valid || n.Date.String() != "2012-03-10" {
+		t.Error("Expected Scan(2012-03-10) to produce a valid NullDate but got", n, err)
+	}
+	if v, err := n.Value(); err != nil || v == nil {
+		t.Error("Expected a valid NullDate to have a non-nil Value but got", v, err)
+	}
+	if err := n.Scan("bogus"); err == nil {
+		t.Error("Expected Scan(bogus) to return an error")
+	}
+}
+
+func TestNullDateEncoding(t *testing.T) {
+	type record struct {
+		D NullDate `json:"d"`
+	}
+	tests := []struct {
+		n    NullDate
+		json string
+		text string
+	}{
+		{NullDate{}, `{"d":null}`, ""},
+		{NullDate{1, true}, `{"d":"1970-01-02"}`, "1970-01-02"},
+		{NullDate{0, true}, `{"d":"1970-01-01"}`, "1970-01-01"},
+	}
+	for _, test := range tests {
+		b, err := json.Marshal(record{test.n})
+		if err != nil || string(b) != test.json {
+			t.Errorf("Expected JSON encoding of %v to be %s but got %s, %v", test.n, test.json, b, err)
+		}
+		rt := record{NullDate{99, true}}
+		if err := json.Unmarshal(b, &rt); err != nil || rt.D != test.n {
+			t.Errorf("Expected JSON round trip of %v but got %v, %v", test.n, rt.D, err)
+		}
+		b, err = test.n.MarshalText()
+		if err != nil || string(b) != test.text {
+			t.Errorf("Expected text encoding of %v to be %q but got %q, %v", test.n, test.text, b, err)
+		}
+		n := NullDate{99, true}
+		if err := n.UnmarshalText(b); err != nil || n != test.n {
+			t.Errorf("Expected text round trip of %v but got %v, %v", test.n, n, err)
+		}
+	}
+	if s := (NullDate{}).String(); s != "NULL" {
+		t.Error("Expected an invalid NullDate to print as NULL but got", s)
+	}
+}
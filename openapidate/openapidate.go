@@ -0,0 +1,78 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package openapidate describes epochdate.Date and epochdate.NullDate in
+// OpenAPI documents generated with github.com/getkin/kin-openapi. Both
+// marshal to JSON as date strings formatted using epochdate.TextLayout, but
+// schema generators which inspect the Go types describe Date as an integer
+// and NullDate as an object.
+//
+// For documents generated by github.com/swaggo/swag, which reads struct tags
+// rather than types, tag Date fields with `swaggertype:"string" format:"date"`.
+package openapidate
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3gen"
+)
+
+var (
+	dateType     = reflect.TypeOf(epochdate.Date(0))
+	nullDateType = reflect.TypeOf(epochdate.NullDate{})
+)
+
+// Schema returns the schema of a JSON-encoded Date: a string formatted using
+// epochdate.TextLayout, which has the "date" format for the default layout,
+// RFC3339. Since JSON Schema has no standard bounds for formatted strings,
+// the representable range of Date is recorded in the x-formatMinimum and
+// x-formatMaximum extensions, as well as in the description.
+func Schema() *openapi3.Schema {
+	min, max := text(epochdate.MinDate), text(epochdate.MaxDate)
+	s := openapi3.NewStringSchema()
+	form := "the form " + strconv.Quote(epochdate.TextLayout)
+	if epochdate.TextLayout == epochdate.RFC3339 {
+		s = s.WithFormat("date")
+		form = "RFC3339 form"
+	}
+	s.Description = "A calendar date in " + form + ", from " + min + " to " + max + "."
+	s.Example = text(15409)
+	s.Extensions = map[string]any{"x-formatMinimum": min, "x-formatMaximum": max}
+	return s
+}
+
+// text returns d as encoded in JSON strings.
+func text(d epochdate.Date) string {
+	b, _ := d.MarshalText()
+	return string(b)
+}
+
+// NullSchema returns the schema of a JSON-encoded NullDate, which is that of
+// Date, but nullable.
+func NullSchema() *openapi3.Schema {
+	return Schema().WithNullable()
+}
+
+// Customize is an openapi3gen.SchemaCustomizerFn which replaces the
+// generated schemas of Date and NullDate values with Schema and NullSchema.
+// Pointers to Dates are handled by the generator.
+func Customize(name string, t reflect.Type, tag reflect.StructTag, schema *openapi3.Schema) error {
+	switch t {
+	case dateType:
+		*schema = *Schema()
+	case nullDateType:
+		*schema = *NullSchema()
+	}
+	return nil
+}
+
+// Option returns an openapi3gen.Option which installs Customize. Since a
+// generator has only one customizer, programs with their own should call
+// Customize from it instead.
+func Option() openapi3gen.Option {
+	return openapi3gen.SchemaCustomizer(Customize)
+}
@@ -0,0 +1,88 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package openapidate
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3gen"
+)
+
+type event struct {
+	Day   epochdate.Date     `json:"day"`
+	Until epochdate.NullDate `json:"until"`
+	Ptr   *epochdate.Date    `json:"ptr"`
+	List  []epochdate.Date   `json:"list"`
+	Count uint16             `json:"count"`
+}
+
+func TestGenerator(t *testing.T) {
+	ref, err := openapi3gen.NewSchemaRefForValue(event{}, nil, Option())
+	if err != nil {
+		t.Fatal("Unexpected NewSchemaRefForValue error:", err)
+	}
+	props := ref.Value.Properties
+	tests := []struct {
+		name     string
+		schema   *openapi3.Schema
+		nullable bool
+	}{
+		{"day", props["day"].Value, false},
+		{"until", props["until"].Value, true},
+		{"ptr", props["ptr"].Value, false},
+		{"list", props["list"].Value.Items.Value, false},
+	}
+	for _, test := range tests {
+		s := test.schema
+		if !s.Type.Is("string") || s.Format != "date" || s.Nullable != test.nullable {
+			t.Errorf("Expected %s to have a nullable: %v date string schema but got %v", test.name, test.nullable, s)
+		}
+	}
+	if s := props["count"].Value; !s.Type.Is("integer") {
+		t.Errorf("Expected count to have an integer schema but got %v", s)
+	}
+}
+
+func TestSchemaValidates(t *testing.T) {
+	s := Schema()
+	if err := s.Validate(context.Background()); err != nil {
+		t.Error("Unexpected Validate error:", err)
+	}
+	for _, d := range []epochdate.Date{epochdate.MinDate, 15409, epochdate.MaxDate} {
+		b, _ := json.Marshal(d)
+		var v any
+		json.Unmarshal(b, &v)
+		if err := s.VisitJSON(v); err != nil {
+			t.Errorf("Expected %s to satisfy the schema but got %v", b, err)
+		}
+	}
+	if err := s.VisitJSON(float64(15409)); err == nil {
+		t.Error("Expected an integer not to satisfy the schema")
+	}
+	if err := NullSchema().VisitJSON(nil); err != nil {
+		t.Error("Expected null to satisfy the nullable schema but got", err)
+	}
+	if s.Extensions["x-formatMaximum"] != "2149-06-06" {
+		t.Errorf("Expected x-formatMaximum to be 2149-06-06 but got %v", s.Extensions["x-formatMaximum"])
+	}
+}
+
+func TestSchemaTextLayout(t *testing.T) {
+	defer func(layout string) { epochdate.TextLayout = layout }(epochdate.TextLayout)
+	epochdate.TextLayout = epochdate.YYYYMMDD
+	s := Schema()
+	if s.Format != "" || s.Example != "20120310" || s.Extensions["x-formatMaximum"] != "21490606" {
+		t.Errorf("Expected a schema for YYYYMMDD strings but got %v", s)
+	}
+	b, _ := json.Marshal(epochdate.Date(15409))
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil || v != s.Example {
+		t.Errorf("Expected the example to match the JSON encoding %s but got %v", b, s.Example)
+	}
+}
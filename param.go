@@ -0,0 +1,19 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+// UnmarshalParam sets d from a path, query, or form parameter in RFC3339
+// form. It implements the BindUnmarshaler interfaces of the echo and gin web
+// frameworks, so that Date may be used directly as a bound parameter type.
+// The error for malformed input is a *ParseError, which frameworks report
+// as a 400 Bad Request.
+func (d *Date) UnmarshalParam(param string) error {
+	v, err := ParseDateOnly(param)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
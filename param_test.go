@@ -0,0 +1,35 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"errors"
+	"testing"
+)
+
+// bindUnmarshaler is the interface shared by echo and gin.
+type bindUnmarshaler interface {
+	UnmarshalParam(param string) error
+}
+
+var _ bindUnmarshaler = new(Date)
+
+func TestUnmarshalParam(t *testing.T) {
+	var d Date
+	if err := d.UnmarshalParam("2012-03-10"); err != nil || d != 15409 {
+		t.Errorf("Expected UnmarshalParam(2012-03-10) to return 15409 but got %d, %v", d, err)
+	}
+	for _, param := range []string{"", "2012-3-10", "2012-03-10T00:00:00Z", "1969-12-31"} {
+		d := Date(7)
+		err := d.UnmarshalParam(param)
+		var perr *ParseError
+		if !errors.As(err, &perr) || perr.Value != param {
+			t.Errorf("Expected UnmarshalParam(%q) to return a *ParseError but got %v", param, err)
+		}
+		if d != 7 {
+			t.Errorf("Expected failed UnmarshalParam(%q) to leave the date unchanged but got %d", param, d)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package parquetdate writes epochdate.Date struct fields as the Parquet
+// DATE logical type using github.com/parquet-go/parquet-go.
+//
+// Since Date is a uint16, parquet.SchemaOf annotates Date fields as 16-bit
+// unsigned integers, and rejects the "date" struct tag option for them. The
+// physical representation is the same 32-bit day count, so only the schema
+// needs to differ: SchemaOf returns a schema in which every Date, *Date, and
+// []Date field is annotated as a DATE, and NewWriter uses such a schema.
+//
+// No special handling is needed for reading: parquet.GenericReader reads both
+// DATE and integer columns into Date fields.
+package parquetdate
+
+import (
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/format"
+)
+
+var dateType = reflect.TypeOf(epochdate.Date(0))
+
+// SchemaOf is like parquet.SchemaOf, but annotates Date fields of model,
+// including those of nested structs, as the DATE logical type. Optional,
+// repeated, encoding, and compression settings derived from struct tags are
+// retained.
+func SchemaOf(model any) *parquet.Schema {
+	s := parquet.SchemaOf(model)
+	t := reflect.TypeOf(model)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return parquet.NewSchema(s.Name(), convert(t, s))
+}
+
+// NewWriter returns a writer of rows of type T to w, using the schema
+// returned by SchemaOf for T. Options which configure the schema are
+// overridden.
+func NewWriter[T any](w io.Writer, options ...parquet.WriterOption) *parquet.GenericWriter[T] {
+	options = append(options, SchemaOf(new(T)))
+	return parquet.NewGenericWriter[T](w, options...)
+}
+
+// convert returns n, the node derived from a value of type t, with Date
+// leaves replaced by DATE leaves.
+func convert(t reflect.Type, n parquet.Node) parquet.Node {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 {
+		t = t.Elem()
+	}
+	var node parquet.Node
+	switch {
+	case t == dateType && n.Leaf():
+		node = parquet.Date()
+	case t == dateType && isList(n):
+		elem := n.Fields()[0].Fields()[0]
+		node = parquet.List(convert(t, elem))
+	case t.Kind() == reflect.Struct && !n.Leaf():
+		fields := make(map[string]reflect.Type)
+		structFields(t, fields)
+		group := make(parquet.Group)
+		for _, f := range n.Fields() {
+			if ft, ok := fields[f.Name()]; ok {
+				group[f.Name()] = convert(ft, f)
+			} else {
+				group[f.Name()] = f
+			}
+		}
+		node = group
+	default:
+		return n
+	}
+	if n.Leaf() {
+		if enc := n.Encoding(); enc != nil {
+			node = parquet.Encoded(node, enc)
+		}
+		if codec := n.Compression(); codec != nil {
+			node = parquet.Compressed(node, codec)
+		}
+	}
+	switch {
+	case n.Optional():
+		node = parquet.Optional(node)
+	case n.Repeated():
+		node = parquet.Repeated(node)
+	}
+	return node
+}
+
+// isList reports whether n is a group annotated as a LIST, as produced by
+// the "list" struct tag option.
+func isList(n parquet.Node) bool {
+	if n.Leaf() {
+		return false
+	}
+	if lt := n.Type().LogicalType(); lt != nil {
+		_, ok := lt.Value.(*format.ListType)
+		return ok
+	}
+	return false
+}
+
+// structFields records the exported fields of struct type t in fields, keyed
+// by column name, flattening embedded structs as parquet.SchemaOf does.
+func structFields(t reflect.Type, fields map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("parquet")
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "-" && tag != "-," {
+			continue
+		}
+		if f.Anonymous {
+			structFields(f.Type, fields)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parquetdate
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/parquet-go/parquet-go"
+)
+
+type Audit struct {
+	Created epochdate.Date `parquet:"created"`
+}
+
+type record struct {
+	Audit
+	Name     string           `parquet:"name"`
+	Start    epochdate.Date   `parquet:"start,delta"`
+	End      *epochdate.Date  `parquet:"end,optional"`
+	Holidays []epochdate.Date `parquet:"holidays,list"`
+	Days     []epochdate.Date `parquet:"days"`
+	Count    uint16           `parquet:"count"`
+	Nested   struct{ Due epochdate.Date }
+	Skipped  epochdate.Date `parquet:"-"`
+}
+
+func isDate(n parquet.Node) bool {
+	return n.Type().String() == parquet.Date().Type().String()
+}
+
+func TestSchemaOf(t *testing.T) {
+	s := SchemaOf(new(record))
+	tests := []struct {
+		path   []string
+		date   bool
+		repeat bool
+	}{
+		{[]string{"created"}, true, false},
+		{[]string{"name"}, false, false},
+		{[]string{"start"}, true, false},
+		{[]string{"end"}, true, false},
+		{[]string{"holidays", "list", "element"}, true, false},
+		{[]string{"days"}, true, true},
+		{[]string{"count"}, false, false},
+		{[]string{"Nested", "Due"}, true, false},
+	}
+	for _, test := range tests {
+		col, ok := s.Lookup(test.path...)
+		if !ok {
+			t.Errorf("Expected SchemaOf to have a %v column", test.path)
+			continue
+		}
+		if isDate(col.Node) != test.date {
+			t.Errorf("Expected %v to be a DATE column: %v, but got %v", test.path, test.date, col.Node.Type())
+		}
+		if col.Node.Repeated() != test.repeat {
+			t.Errorf("Expected %v to be repeated: %v", test.path, test.repeat)
+		}
+	}
+	if col, _ := s.Lookup("end"); !col.Node.Optional() {
+		t.Error("Expected end column to remain optional")
+	}
+	if col, _ := s.Lookup("start"); col.Node.Encoding() == nil {
+		t.Error("Expected start column to retain its encoding")
+	}
+	if _, ok := s.Lookup("Skipped"); ok {
+		t.Error("Expected Skipped field to be omitted")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	end := epochdate.Date(15410)
+	in := []record{
+		{Name: "a", Start: 15409, End: &end, Holidays: []epochdate.Date{1, 2}, Days: []epochdate.Date{3}, Count: 4},
+		{Name: "b", Start: ^epochdate.Date(0)},
+	}
+	in[0].Created = 5
+	in[1].Nested.Due = 6
+	var buf bytes.Buffer
+	w := NewWriter[record](&buf)
+	if _, err := w.Write(in); err != nil {
+		t.Fatal("Unexpected Write error:", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal("Unexpected Close error:", err)
+	}
+	f, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal("Unexpected OpenFile error:", err)
+	}
+	if col, _ := f.Schema().Lookup("start"); !isDate(col.Node) {
+		t.Errorf("Expected the written start column to be a DATE but got %v", col.Node.Type())
+	}
+	r := parquet.NewGenericReader[record](bytes.NewReader(buf.Bytes()))
+	out := make([]record, len(in)+1)
+	n, _ := r.Read(out)
+	out = out[:n]
+	for i := range out {
+		out[i].Holidays = append([]epochdate.Date(nil), out[i].Holidays...)
+		out[i].Days = append([]epochdate.Date(nil), out[i].Days...)
+	}
+	for i := range in {
+		in[i].Holidays = append([]epochdate.Date(nil), in[i].Holidays...)
+		in[i].Days = append([]epochdate.Date(nil), in[i].Days...)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("Expected round trip to return %v but got %v", in, out)
+	}
+}
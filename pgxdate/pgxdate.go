@@ -0,0 +1,151 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pgxdate maps epochdate.Date and epochdate.NullDate to the
+// PostgreSQL date type for github.com/jackc/pgx.
+//
+// Without registration, pgx converts Dates through time.Time using their
+// database/sql methods. The Codec registered here converts directly between
+// Dates and the binary wire format, a 4-byte count of days since Jan 1 2000,
+// as well as the text format.
+package pgxdate
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// pgEpoch is Jan 1 2000, the PostgreSQL date epoch, as a Date.
+const pgEpoch = 10957
+
+// ErrInfinity is returned when scanning the infinite PostgreSQL dates, which
+// have no Date equivalent.
+var ErrInfinity = errors.New("pgxdate: cannot scan infinite date")
+
+// RegisterTypes registers Codec for the date type with the type map of conn,
+// and makes date the default type of Date and NullDate parameters. It is
+// typically called from a pgxpool AfterConnect hook.
+func RegisterTypes(conn *pgx.Conn) {
+	Register(conn.TypeMap())
+}
+
+// Register is like RegisterTypes, but registers with the type map m.
+func Register(m *pgtype.Map) {
+	m.RegisterType(&pgtype.Type{Name: "date", OID: pgtype.DateOID, Codec: Codec{}})
+	m.RegisterDefaultPgType(epochdate.Date(0), "date")
+	m.RegisterDefaultPgType(epochdate.NullDate{}, "date")
+}
+
+// Codec is a pgtype.Codec for the date type which encodes Date and NullDate
+// values, and scans into *Date and *NullDate targets. Other values and
+// targets, such as time.Time and pgtype.Date, are handled by the embedded
+// pgtype.DateCodec.
+type Codec struct {
+	pgtype.DateCodec
+}
+
+// PlanEncode implements pgtype.Codec.
+func (c Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	switch value.(type) {
+	case epochdate.Date, epochdate.NullDate:
+		switch format {
+		case pgtype.BinaryFormatCode:
+			return encodePlan(appendBinary)
+		case pgtype.TextFormatCode:
+			return encodePlan(appendText)
+		}
+		return nil
+	}
+	return c.DateCodec.PlanEncode(m, oid, format, value)
+}
+
+// PlanScan implements pgtype.Codec.
+func (c Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	switch target.(type) {
+	case *epochdate.Date, *epochdate.NullDate:
+		switch format {
+		case pgtype.BinaryFormatCode:
+			return scanPlan(parseBinary)
+		case pgtype.TextFormatCode:
+			return scanPlan(parseText)
+		}
+		return nil
+	}
+	return c.DateCodec.PlanScan(m, oid, format, target)
+}
+
+type encodePlan func(d epochdate.Date, buf []byte) []byte
+
+func (p encodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	var d epochdate.Date
+	switch v := value.(type) {
+	case epochdate.Date:
+		d = v
+	case epochdate.NullDate:
+		if !v.Valid {
+			return nil, nil
+		}
+		d = v.Date
+	}
+	return p(d, buf), nil
+}
+
+func appendBinary(d epochdate.Date, buf []byte) []byte {
+	return binary.BigEndian.AppendUint32(buf, uint32(int32(d)-pgEpoch))
+}
+
+// appendText appends d in the ISO form which PostgreSQL expects of text
+// parameters, regardless of epochdate.TextLayout.
+func appendText(d epochdate.Date, buf []byte) []byte {
+	return d.AppendFormat(buf, epochdate.RFC3339)
+}
+
+type scanPlan func(src []byte) (epochdate.Date, error)
+
+func (p scanPlan) Scan(src []byte, target any) error {
+	if src == nil {
+		if n, ok := target.(*epochdate.NullDate); ok {
+			*n = epochdate.NullDate{}
+			return nil
+		}
+		return fmt.Errorf("pgxdate: cannot scan NULL into %T", target)
+	}
+	d, err := p(src)
+	if err != nil {
+		return err
+	}
+	switch t := target.(type) {
+	case *epochdate.Date:
+		*t = d
+	case *epochdate.NullDate:
+		*t = epochdate.NullDate{Date: d, Valid: true}
+	}
+	return nil
+}
+
+func parseBinary(src []byte) (epochdate.Date, error) {
+	if len(src) != 4 {
+		return 0, fmt.Errorf("pgxdate: invalid length for date: %d", len(src))
+	}
+	days := int32(binary.BigEndian.Uint32(src))
+	if days == math.MaxInt32 || days == math.MinInt32 {
+		return 0, ErrInfinity
+	}
+	return epochdate.FromEpochDay(int64(days) + pgEpoch)
+}
+
+func parseText(src []byte) (epochdate.Date, error) {
+	switch s := string(src); s {
+	case "infinity", "-infinity":
+		return 0, ErrInfinity
+	default:
+		return epochdate.ParseDateOnly(s)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pgxdate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	Register(m)
+	return m
+}
+
+func TestBinary(t *testing.T) {
+	m := newMap()
+	tests := []struct {
+		d epochdate.Date
+		b string
+	}{
+		{0, "\xff\xff\xd5\x33"},
+		{pgEpoch, "\x00\x00\x00\x00"},
+		{15409, "\x00\x00\x11\x64"},
+		{^epochdate.Date(0), "\x00\x00\xd5\x32"},
+	}
+	for _, test := range tests {
+		b, err := m.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, test.d, nil)
+		if err != nil || string(b) != test.b {
+			t.Errorf("Expected binary encoding of %s to be %x but got %x, %v", test.d, test.b, b, err)
+		}
+		var d epochdate.Date
+		if err := m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, []byte(test.b), &d); err != nil || d != test.d {
+			t.Errorf("Expected binary scan of %x to return %s but got %s, %v", test.b, test.d, d, err)
+		}
+		var tm time.Time
+		if err := m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, []byte(test.b), &tm); err != nil || !tm.Equal(test.d.UTC()) {
+			t.Errorf("Expected binary scan of %x into a time.Time to return %v but got %v, %v", test.b, test.d.UTC(), tm, err)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	// Text parameters must be in ISO form whatever the TextLayout.
+	defer func(layout string) { epochdate.TextLayout = layout }(epochdate.TextLayout)
+	epochdate.TextLayout = epochdate.YYYYMMDD
+	m := newMap()
+	d := epochdate.Date(15409)
+	b, err := m.Encode(pgtype.DateOID, pgtype.TextFormatCode, d, nil)
+	if err != nil || string(b) != "2012-03-10" {
+		t.Errorf("Expected text encoding of %s to be 2012-03-10 but got %s, %v", d, b, err)
+	}
+	var got epochdate.Date
+	if err := m.Scan(pgtype.DateOID, pgtype.TextFormatCode, []byte("2012-03-10"), &got); err != nil || got != d {
+		t.Errorf("Expected text scan of 2012-03-10 to return %s but got %s, %v", d, got, err)
+	}
+}
+
+func TestNull(t *testing.T) {
+	m := newMap()
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		b, err := m.Encode(pgtype.DateOID, format, epochdate.NullDate{}, nil)
+		if err != nil || b != nil {
+			t.Errorf("Expected an invalid NullDate to encode as NULL but got %x, %v", b, err)
+		}
+		n := epochdate.NullDate{Date: 1, Valid: true}
+		if err := m.Scan(pgtype.DateOID, format, nil, &n); err != nil || n.Valid {
+			t.Errorf("Expected scanning NULL to return an invalid NullDate but got %v, %v", n, err)
+		}
+		var d epochdate.Date
+		if err := m.Scan(pgtype.DateOID, format, nil, &d); err == nil {
+			t.Error("Expected scanning NULL into a Date to return an error")
+		}
+	}
+	b, err := m.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, epochdate.NullDate{Date: pgEpoch, Valid: true}, nil)
+	if err != nil || string(b) != "\x00\x00\x00\x00" {
+		t.Errorf("Expected a valid NullDate to encode as its date but got %x, %v", b, err)
+	}
+}
+
+func TestScanErrors(t *testing.T) {
+	m := newMap()
+	tests := []struct {
+		format int16
+		src    string
+		error  error
+	}{
+		{pgtype.BinaryFormatCode, "\x7f\xff\xff\xff", ErrInfinity},
+		{pgtype.BinaryFormatCode, "\x80\x00\x00\x00", ErrInfinity},
+		{pgtype.BinaryFormatCode, "\xff\xff\xd5\x32", epochdate.ErrOutOfRange},
+		{pgtype.TextFormatCode, "infinity", ErrInfinity},
+		{pgtype.TextFormatCode, "-infinity", ErrInfinity},
+		{pgtype.TextFormatCode, "2149-06-07", epochdate.ErrOutOfRange},
+	}
+	for _, test := range tests {
+		var d epochdate.Date
+		if err := m.Scan(pgtype.DateOID, test.format, []byte(test.src), &d); !errors.Is(err, test.error) {
+			t.Errorf("Expected scan of %q to return %v but got %v", test.src, test.error, err)
+		}
+	}
+	var d epochdate.Date
+	if err := m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, []byte("\x00\x00"), &d); err == nil {
+		t.Error("Expected scan of a 2-byte value to return an error")
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package protodate converts between epochdate.Date and the google.type.Date
+// well-known protobuf message, as generated in
+// google.golang.org/genproto/googleapis/type/date.
+package protodate
+
+import (
+	"errors"
+	"time"
+
+	"github.com/extemporalgenome/epochdate"
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+// ErrPartialDate is returned by FromProtoDate for messages which do not
+// denote a full date: google.type.Date permits a zero year, month, or day to
+// represent an anniversary, a month, or a year, none of which have a Date
+// equivalent.
+var ErrPartialDate = errors.New("protodate: google.type.Date is not a full date")
+
+// FromProtoDate returns the Date denoted by p. ErrPartialDate is returned
+// for nil or partial dates, epochdate.ErrInvalidDate for fields which do not
+// form a calendar date, and an error wrapping epochdate.ErrOutOfRange for
+// dates which are not representable.
+func FromProtoDate(p *date.Date) (epochdate.Date, error) {
+	if p.GetYear() == 0 || p.GetMonth() == 0 || p.GetDay() == 0 {
+		return 0, ErrPartialDate
+	}
+	return epochdate.NewFromDateStrict(int(p.GetYear()), time.Month(p.GetMonth()), int(p.GetDay()))
+}
+
+// ProtoDate returns the google.type.Date message equivalent to d.
+func ProtoDate(d epochdate.Date) *date.Date {
+	year, month, day := d.Date()
+	return &date.Date{Year: int32(year), Month: int32(month), Day: int32(day)}
+}
@@ -0,0 +1,47 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protodate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/extemporalgenome/epochdate"
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, d := range []epochdate.Date{0, 15409, ^epochdate.Date(0)} {
+		p := ProtoDate(d)
+		if got, err := FromProtoDate(p); err != nil || got != d {
+			t.Errorf("Expected FromProtoDate(ProtoDate(%s)) to return %s but got %s, %v", d, d, got, err)
+		}
+	}
+	p := ProtoDate(15409)
+	if p.Year != 2012 || p.Month != 3 || p.Day != 10 {
+		t.Errorf("Expected ProtoDate(2012-03-10) to return 2012-03-10 but got %d-%d-%d", p.Year, p.Month, p.Day)
+	}
+}
+
+func TestFromProtoDateErrors(t *testing.T) {
+	tests := []struct {
+		p     *date.Date
+		error error
+	}{
+		{nil, ErrPartialDate},
+		{&date.Date{Month: 3, Day: 10}, ErrPartialDate},
+		{&date.Date{Year: 2012, Day: 10}, ErrPartialDate},
+		{&date.Date{Year: 2012, Month: 3}, ErrPartialDate},
+		{&date.Date{Year: 2012, Month: 2, Day: 30}, epochdate.ErrInvalidDate},
+		{&date.Date{Year: 2012, Month: 13, Day: 1}, epochdate.ErrInvalidDate},
+		{&date.Date{Year: 1969, Month: 12, Day: 31}, epochdate.ErrOutOfRange},
+		{&date.Date{Year: 2149, Month: 6, Day: 7}, epochdate.ErrOutOfRange},
+	}
+	for _, test := range tests {
+		if _, err := FromProtoDate(test.p); !errors.Is(err, test.error) {
+			t.Errorf("Expected FromProtoDate(%v) to return %v but got %v", test.p, test.error, err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// Generate implements the Generator interface of testing/quick, producing
+// Dates across the entire representable range. Since edge cases are rare
+// among uniformly chosen dates, about one in eight results is instead one of
+// MinDate, MaxDate, or a leap day. The size hint is ignored.
+func (Date) Generate(rand *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(generate(rand))
+}
+
+// Generate implements the Generator interface of testing/quick, producing
+// invalid NullDates about one time in eight, and otherwise valid NullDates
+// with dates chosen as by Date.Generate.
+func (NullDate) Generate(rand *rand.Rand, size int) reflect.Value {
+	var n NullDate
+	if rand.Intn(8) != 0 {
+		n = NullDate{generate(rand), true}
+	}
+	return reflect.ValueOf(n)
+}
+
+// Generate implements the Generator interface of testing/quick, producing
+// EmptyRange about one time in eight, and otherwise non-empty Ranges between
+// dates chosen as by Date.Generate.
+func (Range) Generate(rand *rand.Rand, size int) reflect.Value {
+	r := EmptyRange
+	if rand.Intn(8) != 0 {
+		r = Range{generate(rand), generate(rand)}
+		if r.End < r.Start {
+			r.Start, r.End = r.End, r.Start
+		}
+	}
+	return reflect.ValueOf(r)
+}
+
+func generate(rand *rand.Rand) Date {
+	if rand.Intn(8) != 0 {
+		return Date(rand.Intn(int(MaxDate) + 1))
+	}
+	switch rand.Intn(3) {
+	case 0:
+		return MinDate
+	case 1:
+		return MaxDate
+	}
+	// Leap years from 1972 through 2148, excepting 2100.
+	year := 1972 + 4*rand.Intn(45)
+	if year == 2100 {
+		year = 2096
+	}
+	return MustNewFromDate(year, 2, 29)
+}
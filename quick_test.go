@@ -0,0 +1,46 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestQuickGenerate(t *testing.T) {
+	var edges, nulls int
+	roundTrip := func(d Date, n NullDate) bool {
+		if d == MinDate || d == MaxDate {
+			edges++
+		}
+		if !n.Valid {
+			nulls++
+			return n.Date == 0
+		}
+		_, month, day := d.Date()
+		if month == 2 && day == 29 && !d.IsLeapYear() {
+			return false
+		}
+		v, err := Parse(RFC3339, d.String())
+		return err == nil && v == d
+	}
+	if err := quick.Check(roundTrip, &quick.Config{MaxCount: 1000}); err != nil {
+		t.Error(err)
+	}
+	var empty int
+	ranges := func(r Range) bool {
+		if r.IsEmpty() {
+			empty++
+			return r == EmptyRange
+		}
+		return r.Validate() == nil && r.Contains(r.Start) && r.Contains(r.End)
+	}
+	if err := quick.Check(ranges, &quick.Config{MaxCount: 1000}); err != nil {
+		t.Error(err)
+	}
+	if edges == 0 || nulls == 0 || empty == 0 {
+		t.Errorf("Expected edge dates, invalid NullDates, and empty Ranges to be generated but got %d, %d, and %d", edges, nulls, empty)
+	}
+}
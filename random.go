@@ -0,0 +1,40 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	crand "crypto/rand"
+	"math/big"
+	"math/rand"
+)
+
+// RandomBetween returns a uniformly distributed Date from lo through hi
+// inclusive, drawn from rng, or from the default source of math/rand if rng
+// is nil. It panics if lo is after hi.
+func RandomBetween(rng *rand.Rand, lo, hi Date) Date {
+	if lo > hi {
+		panic("epochdate: RandomBetween called with lo after hi")
+	}
+	n := int(hi-lo) + 1
+	if rng == nil {
+		return lo + Date(rand.Intn(n))
+	}
+	return lo + Date(rng.Intn(n))
+}
+
+// CryptoRandomBetween is like RandomBetween, but draws from crypto/rand, for
+// uses such as anonymization where the dates must not be predictable. An
+// error is returned only if the system's secure random number generator
+// fails.
+func CryptoRandomBetween(lo, hi Date) (Date, error) {
+	if lo > hi {
+		panic("epochdate: CryptoRandomBetween called with lo after hi")
+	}
+	n, err := crand.Int(crand.Reader, big.NewInt(int64(hi-lo)+1))
+	if err != nil {
+		return 0, err
+	}
+	return lo + Date(n.Int64()), nil
+}
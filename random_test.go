@@ -0,0 +1,46 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRandomBetween(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tests := []struct{ lo, hi Date }{
+		{15409, 15409},
+		{15409, 15415},
+		{MinDate, MaxDate},
+		{MaxDate - 1, MaxDate},
+	}
+	for _, test := range tests {
+		seen := make(map[Date]bool)
+		for i := 0; i < 1000; i++ {
+			d := RandomBetween(rng, test.lo, test.hi)
+			if d < test.lo || d > test.hi {
+				t.Fatalf("Expected RandomBetween(%s, %s) to be within range but got %s", test.lo, test.hi, d)
+			}
+			seen[d] = true
+			c, err := CryptoRandomBetween(test.lo, test.hi)
+			if err != nil || c < test.lo || c > test.hi {
+				t.Fatalf("Expected CryptoRandomBetween(%s, %s) to be within range but got %s, %v", test.lo, test.hi, c, err)
+			}
+		}
+		if n := int(test.hi-test.lo) + 1; n <= 7 && len(seen) != n {
+			t.Errorf("Expected RandomBetween(%s, %s) to produce all %d dates but got %d", test.lo, test.hi, n, len(seen))
+		}
+	}
+	if d := RandomBetween(nil, 15409, 15415); d < 15409 || d > 15415 {
+		t.Errorf("Expected RandomBetween with a nil source to be within range but got %s", d)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected RandomBetween with lo after hi to panic")
+		}
+	}()
+	RandomBetween(rng, 15415, 15409)
+}
@@ -0,0 +1,309 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"errors"
+	"iter"
+	"strings"
+)
+
+// A Range is an interval of dates from Start through End, inclusive: the
+// Range from 2012-03-10 through 2012-03-16 is one week long, and contains both
+// of those dates. Inclusive bounds allow any interval of representable dates,
+// including those ending on the last representable date; use
+// NewRangeExclusive to construct a Range from a half-open interval. A Range
+// whose End is before its Start is empty.
+type Range struct {
+	Start Date // the first date in the range
+	End   Date // the last date in the range
+}
+
+// EmptyRange is the empty Range produced by operations which result in no
+// dates, so that empty results compare equal.
+var EmptyRange = Range{Start: 1, End: 0}
+
+// ErrRangeOrder is returned by NewRange and Range.Validate if a range ends
+// before it begins.
+var ErrRangeOrder = errors.New("epochdate: range ends before it starts")
+
+// NewRange returns the Range from start through end, inclusive. If end is
+// before start, ErrRangeOrder is returned, since such bounds usually indicate
+// transposed input rather than an intentionally empty range.
+func NewRange(start, end Date) (Range, error) {
+	r := Range{start, end}
+	if err := r.Validate(); err != nil {
+		return EmptyRange, err
+	}
+	return r, nil
+}
+
+// NewRangeExclusive returns the Range from start up to but not including end,
+// which is empty if end is start; this is the convention of half-open
+// intervals, such as the SQL predicate d >= start AND d < end.
+// If end is before start, ErrRangeOrder is returned.
+func NewRangeExclusive(start, end Date) (Range, error) {
+	if end < start {
+		return EmptyRange, ErrRangeOrder
+	} else if end == start {
+		return EmptyRange, nil
+	}
+	return Range{start, end - 1}, nil
+}
+
+// Validate returns ErrRangeOrder if r ends before it starts, as for ranges
+// decoded from untrusted input, and nil otherwise.
+func (r Range) Validate() error {
+	if r.End < r.Start {
+		return ErrRangeOrder
+	}
+	return nil
+}
+
+// IsEmpty reports whether r contains no dates.
+func (r Range) IsEmpty() bool {
+	return r.End < r.Start
+}
+
+// Contains reports whether d is within r.
+func (r Range) Contains(d Date) bool {
+	return r.Start <= d && d <= r.End
+}
+
+// Len returns the number of days in r, counting both Start and End, or 0 if
+// r is empty.
+func (r Range) Len() int {
+	if r.IsEmpty() {
+		return 0
+	}
+	return int(r.End-r.Start) + 1
+}
+
+// Overlaps reports whether r and o have any date in common. An empty range
+// overlaps no range, including itself.
+func (r Range) Overlaps(o Range) bool {
+	return !r.IsEmpty() && !o.IsEmpty() && r.Start <= o.End && o.Start <= r.End
+}
+
+// Adjacent reports whether r and o do not overlap, but one ends on the day
+// before the other starts, so that together they form a contiguous range. An
+// empty range is adjacent to no range.
+func (r Range) Adjacent(o Range) bool {
+	if r.IsEmpty() || o.IsEmpty() {
+		return false
+	}
+	return int(r.End)+1 == int(o.Start) || int(o.End)+1 == int(r.Start)
+}
+
+// Intersect returns the dates common to r and o, or EmptyRange if they do not
+// overlap.
+func (r Range) Intersect(o Range) Range {
+	if !r.Overlaps(o) {
+		return EmptyRange
+	}
+	return Range{max(r.Start, o.Start), min(r.End, o.End)}
+}
+
+// Union returns the range containing the dates of both r and o, and true, if
+// they overlap or are adjacent. Otherwise, since their union would not be a
+// single range, it returns EmptyRange and false. The union of an empty range
+// with any range o is o.
+func (r Range) Union(o Range) (Range, bool) {
+	switch {
+	case r.IsEmpty() && o.IsEmpty():
+		return EmptyRange, true
+	case r.IsEmpty():
+		return o, true
+	case o.IsEmpty():
+		return r, true
+	case !r.Overlaps(o) && !r.Adjacent(o):
+		return EmptyRange, false
+	}
+	return Range{min(r.Start, o.Start), max(r.End, o.End)}, true
+}
+
+// Dates returns an iterator over the dates of r in ascending order:
+//
+//	for d := range r.Dates() {
+//		...
+//	}
+func (r Range) Dates() iter.Seq[Date] {
+	return r.Every(1)
+}
+
+// Every returns an iterator over every nth date of r in ascending order,
+// beginning with Start, so that Every(7) yields dates falling on the same
+// weekday as Start. It panics if n is not positive.
+func (r Range) Every(n int) iter.Seq[Date] {
+	if n <= 0 {
+		panic("epochdate: Range.Every called with non-positive step")
+	}
+	return func(yield func(Date) bool) {
+		// Count in int, so that a range ending on MaxDate terminates, and
+		// stop before a step past End could overflow.
+		for d := int(r.Start); d <= int(r.End); d += n {
+			if !yield(Date(d)) || n > int(r.End)-d {
+				return
+			}
+		}
+	}
+}
+
+// isoInterval names the form of ISO 8601 intervals in ParseErrors.
+const isoInterval = "2006-01-02/2006-01-02"
+
+// String returns r as an ISO 8601 time interval of its Start and End dates,
+// such as "2012-03-10/2012-03-16", or "empty" if r is empty.
+func (r Range) String() string {
+	if r.IsEmpty() {
+		return "empty"
+	}
+	return r.Start.String() + "/" + r.End.String()
+}
+
+// MarshalText implements encoding.TextMarshaler, producing r in the form
+// returned by String, or empty text if r is empty.
+func (r Range) MarshalText() ([]byte, error) {
+	if r.IsEmpty() {
+		return []byte{}, nil
+	}
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing data as by
+// ParseRange. Empty text yields EmptyRange.
+func (r *Range) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*r = EmptyRange
+		return nil
+	}
+	v, err := ParseRange(string(data))
+	if err != nil {
+		return err
+	}
+	*r = v
+	return nil
+}
+
+// ParseRange parses an ISO 8601 time interval of dates in one of three forms:
+//
+//	2012-03-10/2012-03-16   start and end, both included
+//	2012-03-10/P1W          start and duration
+//	P1W/2012-03-16          duration and end
+//
+// Durations are of the form PnYnMnWnD, with each component optional but in
+// that order, and may not have a time part. As in ISO 8601, a duration
+// covers whole days from the start, so "2012-03-10/P1W" ends on 2012-03-16.
+// Adding months to a day which the resulting month lacks reaches its last
+// day, so that "2012-01-31/P1M" ends the day before 2012-02-29. A zero
+// duration describes an empty range. Any error returned is a *ParseError,
+// wrapping ErrRangeOrder if the end is before the start.
+func ParseRange(s string) (Range, error) {
+	start, end, ok := strings.Cut(s, "/")
+	if !ok {
+		return EmptyRange, &ParseError{isoInterval, s, errSyntax}
+	}
+	var (
+		r   Range
+		err error
+	)
+	switch {
+	case strings.HasPrefix(start, "P") && strings.HasPrefix(end, "P"):
+		err = errSyntax
+	case strings.HasPrefix(end, "P"):
+		r, err = rangeFromDuration(start, end, 1)
+	case strings.HasPrefix(start, "P"):
+		r, err = rangeFromDuration(end, start, -1)
+	default:
+		if r.Start, err = ParseDateOnly(start); err == nil {
+			if r.End, err = ParseDateOnly(end); err == nil {
+				err = r.Validate()
+			}
+		}
+	}
+	if err != nil {
+		var perr *ParseError
+		if errors.As(err, &perr) {
+			err = perr.Err
+		}
+		return EmptyRange, &ParseError{isoInterval, s, err}
+	}
+	return r, nil
+}
+
+// rangeFromDuration returns the range covering the duration from the date
+// bound, forward from its start if sign is 1, or backward from its end if
+// sign is -1.
+func rangeFromDuration(bound, duration string, sign int) (Range, error) {
+	d, err := ParseDateOnly(bound)
+	if err != nil {
+		return EmptyRange, err
+	}
+	months, days, err := parseDuration(duration)
+	if err != nil {
+		return EmptyRange, err
+	}
+	if months == 0 && days == 0 {
+		return EmptyRange, nil
+	}
+	// Work from the exclusive end of the range, so that a duration of a
+	// month ending on Mar 31 begins on Mar 1.
+	from := int(d)
+	if sign < 0 {
+		from++
+	}
+	y, m, day := civil(from)
+	to := addMonths(y, m, day, sign*months) + sign*days
+	if sign < 0 {
+		from, to = to, from
+	}
+	start, err := FromDays(from)
+	if err != nil {
+		return EmptyRange, err
+	}
+	last, err := FromDays(to - 1)
+	if err != nil {
+		return EmptyRange, err
+	}
+	return Range{start, last}, nil
+}
+
+// parseDuration parses an ISO 8601 duration of whole days, as a number of
+// months and days.
+func parseDuration(s string) (months, days int, err error) {
+	s = s[1:]
+	if s == "" {
+		return 0, 0, errSyntax
+	}
+	const designators = "YMWD"
+	next := 0
+	for s != "" {
+		i := 0
+		for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+			i++
+		}
+		if i == 0 || i > 5 || i == len(s) {
+			return 0, 0, errSyntax
+		}
+		n, _ := atoi(s[:i])
+		j := strings.IndexByte(designators[next:], s[i])
+		if j < 0 {
+			return 0, 0, errSyntax
+		}
+		switch next += j; designators[next] {
+		case 'Y':
+			months += 12 * n
+		case 'M':
+			months += n
+		case 'W':
+			days += 7 * n
+		case 'D':
+			days += n
+		}
+		next++
+		s = s[i+1:]
+	}
+	return months, days, nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		r     Range
+		len   int
+		empty bool
+	}{
+		{Range{15409, 15415}, 7, false},
+		{Range{15409, 15409}, 1, false},
+		{Range{MinDate, MaxDate}, 1 << 16, false},
+		{Range{15409, 15408}, 0, true},
+		{EmptyRange, 0, true},
+	}
+	for _, test := range tests {
+		if n := test.r.Len(); n != test.len {
+			t.Errorf("Expected %v.Len() to return %d but got %d", test.r, test.len, n)
+		}
+		if empty := test.r.IsEmpty(); empty != test.empty {
+			t.Errorf("Expected %v.IsEmpty() to return %v but got %v", test.r, test.empty, empty)
+		}
+		if err := test.r.Validate(); (err == nil) == test.empty {
+			t.Errorf("Expected %v.Validate() to return an error only if empty but got %v", test.r, err)
+		}
+		if test.r.Contains(test.r.Start) == test.empty || test.r.Contains(test.r.End) == test.empty {
+			t.Errorf("Expected %v to contain its bounds only if not empty", test.r)
+		}
+	}
+	r := Range{15409, 15415}
+	for d, want := range map[Date]bool{15408: false, 15409: true, 15412: true, 15415: true, 15416: false} {
+		if r.Contains(d) != want {
+			t.Errorf("Expected %v.Contains(%s) to return %v", r, d, want)
+		}
+	}
+}
+
+func TestNewRange(t *testing.T) {
+	tests := []struct {
+		start, end   Date
+		incl, excl   Range
+		inclOK, exOK bool
+	}{
+		{15409, 15416, Range{15409, 15416}, Range{15409, 15415}, true, true},
+		{15409, 15409, Range{15409, 15409}, EmptyRange, true, true},
+		{0, 0, Range{0, 0}, EmptyRange, true, true},
+		{0, MaxDate, Range{0, MaxDate}, Range{0, MaxDate - 1}, true, true},
+		{15416, 15409, EmptyRange, EmptyRange, false, false},
+	}
+	for _, test := range tests {
+		if r, err := NewRange(test.start, test.end); (err == nil) != test.inclOK || r != test.incl {
+			t.Errorf("Expected NewRange(%s, %s) to return %v, %v but got %v, %v", test.start, test.end, test.incl, test.inclOK, r, err)
+		}
+		if r, err := NewRangeExclusive(test.start, test.end); (err == nil) != test.exOK || r != test.excl {
+			t.Errorf("Expected NewRangeExclusive(%s, %s) to return %v, %v but got %v, %v", test.start, test.end, test.excl, test.exOK, r, err)
+		}
+	}
+	if _, err := NewRange(15416, 15409); err != ErrRangeOrder {
+		t.Errorf("Expected NewRange with transposed bounds to return ErrRangeOrder but got %v", err)
+	}
+}
+
+func TestRangeSetOperations(t *testing.T) {
+	tests := []struct {
+		r, o      Range
+		overlaps  bool
+		adjacent  bool
+		intersect Range
+		union     Range
+		unionOK   bool
+	}{
+		{Range{10, 20}, Range{15, 25}, true, false, Range{15, 20}, Range{10, 25}, true},
+		{Range{10, 20}, Range{12, 14}, true, false, Range{12, 14}, Range{10, 20}, true},
+		{Range{10, 20}, Range{20, 30}, true, false, Range{20, 20}, Range{10, 30}, true},
+		{Range{10, 20}, Range{21, 30}, false, true, EmptyRange, Range{10, 30}, true},
+		{Range{21, 30}, Range{10, 20}, false, true, EmptyRange, Range{10, 30}, true},
+		{Range{10, 20}, Range{22, 30}, false, false, EmptyRange, EmptyRange, false},
+		{Range{0, 0}, Range{MaxDate, MaxDate}, false, false, EmptyRange, EmptyRange, false},
+		{Range{MaxDate - 1, MaxDate - 1}, Range{MaxDate, MaxDate}, false, true, EmptyRange, Range{MaxDate - 1, MaxDate}, true},
+		{Range{10, 20}, EmptyRange, false, false, EmptyRange, Range{10, 20}, true},
+		{EmptyRange, Range{10, 20}, false, false, EmptyRange, Range{10, 20}, true},
+		{Range{10, 5}, Range{0, 30}, false, false, EmptyRange, Range{0, 30}, true},
+		{EmptyRange, EmptyRange, false, false, EmptyRange, EmptyRange, true},
+	}
+	for _, test := range tests {
+		if v := test.r.Overlaps(test.o); v != test.overlaps {
+			t.Errorf("Expected %v.Overlaps(%v) to return %v but got %v", test.r, test.o, test.overlaps, v)
+		}
+		if v := test.r.Adjacent(test.o); v != test.adjacent {
+			t.Errorf("Expected %v.Adjacent(%v) to return %v but got %v", test.r, test.o, test.adjacent, v)
+		}
+		if v := test.r.Intersect(test.o); v != test.intersect {
+			t.Errorf("Expected %v.Intersect(%v) to return %v but got %v", test.r, test.o, test.intersect, v)
+		}
+		if v, ok := test.r.Union(test.o); v != test.union || ok != test.unionOK {
+			t.Errorf("Expected %v.Union(%v) to return %v, %v but got %v, %v", test.r, test.o, test.union, test.unionOK, v, ok)
+		}
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		s          string
+		start, end string
+	}{
+		{"2012-03-10/2012-03-16", "2012-03-10", "2012-03-16"},
+		{"2012-03-10/2012-03-10", "2012-03-10", "2012-03-10"},
+		{"2024-01-01/P1M", "2024-01-01", "2024-01-31"},
+		{"2024-01-01/P3M", "2024-01-01", "2024-03-31"},
+		{"2012-03-10/P1W", "2012-03-10", "2012-03-16"},
+		{"2012-01-31/P1M", "2012-01-31", "2012-02-28"},
+		{"P1M/2012-03-30", "2012-02-29", "2012-03-30"},
+		{"2012-03-10/P1Y2M1W3D", "2012-03-10", "2013-05-19"},
+		{"2012-03-10/P1D", "2012-03-10", "2012-03-10"},
+		{"P1M/2024-03-31", "2024-03-01", "2024-03-31"},
+		{"P1W/2012-03-16", "2012-03-10", "2012-03-16"},
+		{"P1D/2149-06-06", "2149-06-06", "2149-06-06"},
+		{"1970-01-01/P65536D", "1970-01-01", "2149-06-06"},
+	}
+	for _, test := range tests {
+		want := Range{MustParse(RFC3339, test.start), MustParse(RFC3339, test.end)}
+		if r, err := ParseRange(test.s); err != nil || r != want {
+			t.Errorf("Expected ParseRange(%q) to return %v but got %v, %v", test.s, want, r, err)
+		}
+	}
+	if r, err := ParseRange("2012-03-10/P0D"); err != nil || r != EmptyRange {
+		t.Errorf("Expected a zero duration to produce EmptyRange but got %v, %v", r, err)
+	}
+	bad := []string{
+		"", "2012-03-10", "2012-03-10/", "/2012-03-10", "2012-03-10/2012-03-09",
+		"P1D/P1D", "2012-03-10/P", "2012-03-10/PT1H", "2012-03-10/P1DT1H",
+		"2012-03-10/P1D1M", "2012-03-10/P1M1M", "2012-03-10/PM", "2012-03-10/P-1D",
+		"2012-03-10/P1", "2012-03-10/P999999D", "2149-06-06/P2D", "P1D/1970-01-01x",
+		"2012-03-10/2012-03-16/P1D",
+	}
+	for _, s := range bad {
+		r, err := ParseRange(s)
+		var perr *ParseError
+		if !errors.As(err, &perr) || perr.Value != s {
+			t.Errorf("Expected ParseRange(%q) to return a *ParseError but got %v, %v", s, r, err)
+		}
+	}
+	if _, err := ParseRange("2012-03-10/2012-03-09"); !errors.Is(err, ErrRangeOrder) {
+		t.Errorf("Expected a transposed interval to return ErrRangeOrder but got %v", err)
+	}
+	if _, err := ParseRange("2149-06-06/P2D"); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Expected an interval past MaxDate to return ErrOutOfRange but got %v", err)
+	}
+}
+
+func TestRangeText(t *testing.T) {
+	r := Range{15409, 15415}
+	if s := r.String(); s != "2012-03-10/2012-03-16" {
+		t.Errorf("Expected String to return 2012-03-10/2012-03-16 but got %s", s)
+	}
+	if s := EmptyRange.String(); s != "empty" {
+		t.Errorf("Expected String of an empty range to return empty but got %s", s)
+	}
+	var v struct{ A, B Range }
+	b, err := json.Marshal(struct{ A, B Range }{r, Range{5, 4}})
+	const want = `{"A":"2012-03-10/2012-03-16","B":""}`
+	if err != nil || string(b) != want {
+		t.Fatalf("Expected json.Marshal to return %s but got %s, %v", want, b, err)
+	}
+	if err := json.Unmarshal(b, &v); err != nil || v.A != r || v.B != EmptyRange {
+		t.Errorf("Expected json.Unmarshal to return %v and %v but got %v, %v", r, EmptyRange, v, err)
+	}
+	if err := json.Unmarshal([]byte(`{"A":"2012-03-10"}`), &v); err == nil {
+		t.Error("Expected json.Unmarshal of a bare date to fail")
+	}
+}
+
+func TestRangeDates(t *testing.T) {
+	tests := []struct {
+		r    Range
+		n    int
+		want []Date
+	}{
+		{Range{15409, 15412}, 1, []Date{15409, 15410, 15411, 15412}},
+		{Range{15409, 15409}, 1, []Date{15409}},
+		{Range{15409, 15423}, 7, []Date{15409, 15416, 15423}},
+		{Range{15409, 15422}, 7, []Date{15409, 15416}},
+		{Range{MaxDate - 2, MaxDate}, 1, []Date{MaxDate - 2, MaxDate - 1, MaxDate}},
+		{Range{MaxDate - 2, MaxDate}, 2, []Date{MaxDate - 2, MaxDate}},
+		{Range{0, MaxDate}, 1 << 15, []Date{0, 1 << 15}},
+		{Range{0, MaxDate}, math.MaxInt, []Date{0}},
+		{Range{MaxDate, MaxDate}, math.MaxInt, []Date{MaxDate}},
+		{EmptyRange, 1, nil},
+	}
+	for _, test := range tests {
+		var got []Date
+		if test.n == 1 {
+			got = slices.Collect(test.r.Dates())
+		} else {
+			got = slices.Collect(test.r.Every(test.n))
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("Expected %v.Every(%d) to yield %v but got %v", test.r, test.n, test.want, got)
+		}
+	}
+	var n int
+	for d := range (Range{15409, 15415}).Dates() {
+		if n++; d == 15411 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("Expected iteration to stop after 3 dates but got %d", n)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected Every(0) to panic")
+		}
+	}()
+	(Range{15409, 15415}).Every(0)
+}
+
+func FuzzParseRange(f *testing.F) {
+	for _, seed := range []string{"2012-03-10/2012-03-16", "2024-01-01/P1M", "P1Y2M1W3D/2149-06-06", "2012-03-10/P99999Y"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		r, err := ParseRange(s)
+		if err != nil || r.IsEmpty() {
+			return
+		}
+		if round, err := ParseRange(r.String()); err != nil || round != r {
+			t.Fatalf("ParseRange(%q) = %v, which reparses as %v, %v", s, r, round, err)
+		}
+	})
+}
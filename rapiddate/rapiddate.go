@@ -0,0 +1,48 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rapiddate provides generators of epochdate values for
+// property-based testing with pgregory.net/rapid.
+package rapiddate
+
+import (
+	"slices"
+
+	"github.com/extemporalgenome/epochdate"
+	"pgregory.net/rapid"
+)
+
+// Date returns a generator of Dates across the entire representable range,
+// shrinking towards Jan 1 1970.
+func Date() *rapid.Generator[epochdate.Date] {
+	return Between(epochdate.MinDate, epochdate.MaxDate)
+}
+
+// Between returns a generator of Dates from min through max inclusive,
+// shrinking towards min. It panics if min is after max.
+func Between(min, max epochdate.Date) *rapid.Generator[epochdate.Date] {
+	return rapid.Map(rapid.Uint16Range(uint16(min), uint16(max)), func(n uint16) epochdate.Date {
+		return epochdate.Date(n)
+	})
+}
+
+// NullDate returns a generator of NullDates, which are valid with dates
+// produced by g, or invalid.
+func NullDate(g *rapid.Generator[epochdate.Date]) *rapid.Generator[epochdate.NullDate] {
+	return rapid.OneOf(
+		rapid.Just(epochdate.NullDate{}),
+		rapid.Map(g, func(d epochdate.Date) epochdate.NullDate {
+			return epochdate.NullDate{Date: d, Valid: true}
+		}),
+	)
+}
+
+// Sorted returns a generator of ascending slices of Dates produced by g,
+// which may include duplicates.
+func Sorted(g *rapid.Generator[epochdate.Date]) *rapid.Generator[[]epochdate.Date] {
+	return rapid.Map(rapid.SliceOf(g), func(dates []epochdate.Date) []epochdate.Date {
+		slices.Sort(dates)
+		return dates
+	})
+}
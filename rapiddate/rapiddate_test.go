@@ -0,0 +1,51 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rapiddate
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/extemporalgenome/epochdate"
+	"pgregory.net/rapid"
+)
+
+func TestBetween(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		min := Date().Draw(t, "min")
+		max := Between(min, epochdate.MaxDate).Draw(t, "max")
+		d := Between(min, max).Draw(t, "d")
+		if d < min || d > max {
+			t.Fatalf("Expected %s to be within [%s, %s]", d, min, max)
+		}
+	})
+}
+
+func TestNullDate(t *testing.T) {
+	var valid, invalid bool
+	rapid.Check(t, func(t *rapid.T) {
+		n := NullDate(Between(15409, 15409)).Draw(t, "n")
+		if n.Valid {
+			valid = true
+			if n.Date != 15409 {
+				t.Fatalf("Expected a valid NullDate to be 2012-03-10 but got %s", n.Date)
+			}
+		} else {
+			invalid = true
+		}
+	})
+	if !valid || !invalid {
+		t.Errorf("Expected both valid and invalid NullDates but got %v and %v", valid, invalid)
+	}
+}
+
+func TestSorted(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		dates := Sorted(Date()).Draw(t, "dates")
+		if !slices.IsSorted(dates) {
+			t.Fatalf("Expected %v to be sorted", dates)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+// RolloutFraction returns the fraction, in [0,1], of a linear rollout which
+// begins on start and reaches completion over rampDays days. The fraction is
+// 1/rampDays on start itself, and 1 from the date start+rampDays-1 onward;
+// before start it is 0. If rampDays is not positive, the rollout is complete
+// as of start.
+func RolloutFraction(start Date, rampDays int, now Date) float64 {
+	if now < start {
+		return 0
+	}
+	elapsed := int(now-start) + 1
+	if rampDays <= 0 || elapsed >= rampDays {
+		return 1
+	}
+	return float64(elapsed) / float64(rampDays)
+}
+
+// InCohort reports whether the subject identified by id falls within the
+// given fraction of subjects for the bucketing date d. Assignment is a
+// deterministic hash of id and d, uniformly distributed across ids, so a
+// subject in the cohort for some fraction is also in it for every larger
+// fraction on the same d. Pass a fixed date, such as the start of a rollout,
+// for stable membership combined with RolloutFraction; pass the current date
+// to re-randomize cohorts daily.
+func InCohort(id uint64, d Date, fraction float64) bool {
+	if fraction <= 0 {
+		return false
+	} else if fraction >= 1 {
+		return true
+	}
+	// Compare the top 53 bits of the hash, as a value in [0,1), to fraction.
+	h := mix64(id ^ mix64(uint64(d)+1))
+	return float64(h>>11)/(1<<53) < fraction
+}
+
+// mix64 is the SplitMix64 finalizer, a fast bijective hash of 64-bit values.
+func mix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ x>>30) * 0xbf58476d1ce4e5b9
+	x = (x ^ x>>27) * 0x94d049bb133111eb
+	return x ^ x>>31
+}
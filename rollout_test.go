@@ -0,0 +1,66 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRolloutFraction(t *testing.T) {
+	const start = Date(15409)
+	tests := []struct {
+		ramp int
+		now  Date
+		want float64
+	}{
+		{10, start - 1, 0},
+		{10, start, 0.1},
+		{10, start + 4, 0.5},
+		{10, start + 9, 1},
+		{10, start + 100, 1},
+		{0, start - 1, 0},
+		{0, start, 1},
+		{-5, start, 1},
+	}
+	for _, test := range tests {
+		if f := RolloutFraction(start, test.ramp, test.now); f != test.want {
+			t.Errorf("Expected RolloutFraction(%s, %d, %s) to return %v but got %v",
+				start, test.ramp, test.now, test.want, f)
+		}
+	}
+}
+
+func TestInCohort(t *testing.T) {
+	const (
+		d = Date(15409)
+		n = 100000
+	)
+	for _, fraction := range []float64{0, 0.01, 0.25, 0.5, 1} {
+		count := 0
+		for id := uint64(0); id < n; id++ {
+			if InCohort(id, d, fraction) {
+				count++
+			}
+		}
+		if got := float64(count) / n; math.Abs(got-fraction) > 0.01 {
+			t.Errorf("Expected InCohort to select about %v of ids but got %v", fraction, got)
+		}
+	}
+	for id := uint64(0); id < 1000; id++ {
+		if InCohort(id, d, 0.2) && !InCohort(id, d, 0.3) {
+			t.Fatalf("Expected id %d to remain in the cohort as the fraction grows", id)
+		}
+	}
+	same := 0
+	for id := uint64(0); id < 1000; id++ {
+		if InCohort(id, d, 0.5) == InCohort(id, d+1, 0.5) {
+			same++
+		}
+	}
+	if same > 600 {
+		t.Error("Expected cohorts on different dates to be independent, but", same, "of 1000 matched")
+	}
+}
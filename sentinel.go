@@ -0,0 +1,47 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+// Invalid is a sentinel for the absence of a date, for applications which
+// must represent "no date" in a bare Date, such as in its 2-byte binary
+// encoding, rather than use NullDate. It is the last representable date,
+// 2149-06-06, so that opting into the sentinel shrinks the range of dates by
+// one day. Only IsValid, OrInvalid, Sentinel, and Optional treat Invalid
+// specially; elsewhere it is an ordinary date.
+const Invalid = MaxDate
+
+// IsValid reports whether d is not the Invalid sentinel.
+func (d Date) IsValid() bool {
+	return d != Invalid
+}
+
+// OrInvalid returns d, or Invalid if err is non-nil. It wraps calls to the
+// constructors and parsers:
+//
+//	d := epochdate.OrInvalid(epochdate.Parse(epochdate.RFC3339, s))
+func OrInvalid(d Date, err error) Date {
+	if err != nil {
+		return Invalid
+	}
+	return d
+}
+
+// Sentinel returns the date of n, or Invalid if n is not valid. A valid n on
+// the last representable date is indistinguishable from an invalid one.
+func (n NullDate) Sentinel() Date {
+	if !n.Valid {
+		return Invalid
+	}
+	return n.Date
+}
+
+// Optional returns d as a NullDate, which is not valid if d is Invalid. It is
+// the inverse of NullDate.Sentinel.
+func (d Date) Optional() NullDate {
+	if !d.IsValid() {
+		return NullDate{}
+	}
+	return NullDate{d, true}
+}
@@ -0,0 +1,39 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import "testing"
+
+func TestSentinel(t *testing.T) {
+	if Invalid != MustNewFromDate(2149, 6, 6) {
+		t.Errorf("Expected Invalid to be the last representable date but got %s", Invalid)
+	}
+	if d := OrInvalid(Parse(RFC3339, "2012-03-10")); d != 15409 || !d.IsValid() {
+		t.Errorf("Expected OrInvalid to return 2012-03-10 but got %s", d)
+	}
+	if d := OrInvalid(Parse(RFC3339, "bogus")); d != Invalid || d.IsValid() {
+		t.Errorf("Expected OrInvalid of an error to return Invalid but got %s", d)
+	}
+	tests := []struct {
+		n NullDate
+		d Date
+	}{
+		{NullDate{}, Invalid},
+		{NullDate{0, true}, 0},
+		{NullDate{15409, true}, 15409},
+	}
+	for _, test := range tests {
+		if d := test.n.Sentinel(); d != test.d {
+			t.Errorf("Expected %v.Sentinel() to return %s but got %s", test.n, test.d, d)
+		}
+		if n := test.d.Optional(); n != test.n {
+			t.Errorf("Expected %s.Optional() to return %v but got %v", test.d, test.n, n)
+		}
+	}
+	b, _ := Invalid.MarshalBinary()
+	if string(b) != "\xff\xff" {
+		t.Errorf("Expected Invalid to encode as ffff but got %x", b)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import "slices"
+
+// SortDates sorts dates in ascending order.
+func SortDates(dates []Date) {
+	slices.Sort(dates)
+}
+
+// SearchDate returns the index of the first date in sorted which is not
+// before d, or len(sorted) if there is none; this is the index at which d
+// would be inserted to keep sorted in order. As for sort.SearchInts, sorted
+// must be in ascending order.
+func SearchDate(sorted []Date, d Date) int {
+	i, _ := slices.BinarySearch(sorted, d)
+	return i
+}
+
+// ContainsSorted reports whether d is in sorted, which must be in ascending
+// order.
+func ContainsSorted(sorted []Date, d Date) bool {
+	_, found := slices.BinarySearch(sorted, d)
+	return found
+}
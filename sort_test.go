@@ -0,0 +1,40 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSortSearch(t *testing.T) {
+	dates := []Date{15409, MaxDate, 0, 15409, 15340}
+	SortDates(dates)
+	if want := []Date{0, 15340, 15409, 15409, MaxDate}; !slices.Equal(dates, want) {
+		t.Fatalf("Expected SortDates to return %v but got %v", want, dates)
+	}
+	tests := []struct {
+		d     Date
+		index int
+		found bool
+	}{
+		{0, 0, true},
+		{1, 1, false},
+		{15409, 2, true},
+		{15410, 4, false},
+		{MaxDate, 4, true},
+	}
+	for _, test := range tests {
+		if i := SearchDate(dates, test.d); i != test.index {
+			t.Errorf("Expected SearchDate(%s) to return %d but got %d", test.d, test.index, i)
+		}
+		if found := ContainsSorted(dates, test.d); found != test.found {
+			t.Errorf("Expected ContainsSorted(%s) to return %v but got %v", test.d, test.found, found)
+		}
+	}
+	if i := SearchDate(nil, 15409); i != 0 || ContainsSorted(nil, 15409) {
+		t.Errorf("Expected an empty slice to contain nothing but got index %d", i)
+	}
+}
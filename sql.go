@@ -0,0 +1,80 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Scan implements sql.Scanner, so that Date may be used as a scan
+// destination for DATE columns. Drivers which return a time.Time have the
+// date in that time's location retained, as with NewFromTime; drivers which
+// return text must produce RFC3339 dates, optionally followed by a time of
+// day separated with a space or "T", which is ignored. Integers are taken to
+// be day numbers, as stored by DateNum, so that columns of either kind may be
+// scanned into a Date; an error wrapping ErrOutOfRange is returned for day
+// numbers outside of Date's representable range. NULL values cannot be
+// scanned into a Date; use NullDate for nullable columns. MySQL zero dates
+// ("0000-00-00") result in ErrZeroDate; NullDate treats them as NULL.
+func (d *Date) Scan(src interface{}) error {
+	if isZeroDate(src) {
+		return ErrZeroDate
+	}
+	var err error
+	switch v := src.(type) {
+	case time.Time:
+		*d, err = NewFromTime(v)
+	case string:
+		*d, err = scanText(v)
+	case []byte:
+		*d, err = scanText(string(v))
+	case int64:
+		*d, err = FromEpochDay(v)
+	case nil:
+		return errors.New("epochdate: cannot scan NULL into Date")
+	default:
+		return fmt.Errorf("epochdate: cannot scan %T into Date", src)
+	}
+	return err
+}
+
+// ErrZeroDate is returned by Date.Scan for MySQL zero dates, which MySQL
+// stores in place of absent or invalid dates unless its NO_ZERO_DATE mode is
+// enabled.
+var ErrZeroDate = errors.New("epochdate: cannot scan zero date (0000-00-00) into Date")
+
+// isZeroDate reports whether src is a MySQL zero date, either as text, or as
+// the zero time.Time produced by github.com/go-sql-driver/mysql when its
+// parseTime option is set.
+func isZeroDate(src interface{}) bool {
+	const zero = "0000-00-00"
+	switch v := src.(type) {
+	case time.Time:
+		return v.IsZero()
+	case string:
+		return strings.HasPrefix(v, zero) && (len(v) == len(zero) || v[len(zero)] == ' ')
+	case []byte:
+		return isZeroDate(string(v))
+	}
+	return false
+}
+
+// scanText parses a textual DATE, DATETIME, or TIMESTAMP column value.
+func scanText(s string) (Date, error) {
+	if len(s) > len(RFC3339) && (s[len(RFC3339)] == ' ' || s[len(RFC3339)] == 'T') {
+		s = s[:len(RFC3339)]
+	}
+	return ParseDateOnly(s)
+}
+
+// Value implements driver.Valuer, representing d as a time.Time at midnight
+// UTC on that date, which drivers store as a DATE.
+func (d Date) Value() (driver.Value, error) {
+	return d.UTC(), nil
+}
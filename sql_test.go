@@ -0,0 +1,76 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+var (
+	_ sql.Scanner   = new(Date)
+	_ driver.Valuer = Date(0)
+)
+
+func TestScan(t *testing.T) {
+	want := MustParse(RFC3339, "2012-03-10")
+	east := time.FixedZone("east", +14*60*60)
+	sources := []interface{}{
+		time.Date(2012, 3, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2012, 3, 10, 23, 59, 59, 0, east),
+		"2012-03-10",
+		[]byte("2012-03-10"),
+		"2012-03-10 00:00:00",
+		"2012-03-10T00:00:00Z",
+		int64(15409),
+	}
+	for _, src := range sources {
+		var d Date
+		if err := d.Scan(src); err != nil {
+			t.Errorf("Unexpected Scan(%#v) error: %v", src, err)
+		} else if d != want {
+			t.Errorf("Expected Scan(%#v) to produce %s but got %s", src, want, d)
+		}
+	}
+	for _, src := range []interface{}{nil, 3.5, int64(-1), int64(65536), "2012-03-10X", "bogus", time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)} {
+		var d Date
+		if err := d.Scan(src); err == nil {
+			t.Errorf("Expected Scan(%#v) to return an error but got %s", src, d)
+		}
+	}
+}
+
+func TestScanZeroDate(t *testing.T) {
+	for _, src := range []interface{}{"0000-00-00", []byte("0000-00-00"), "0000-00-00 00:00:00", time.Time{}} {
+		d := Date(7)
+		if err := d.Scan(src); err != ErrZeroDate {
+			t.Errorf("Expected Scan(%#v) to return ErrZeroDate but got %v", src, err)
+		}
+		n := NullDate{Date: 7, Valid: true}
+		if err := n.Scan(src); err != nil || n.Valid {
+			t.Errorf("Expected NullDate.Scan(%#v) to produce an invalid NullDate but got %v, %v", src, n, err)
+		}
+	}
+	var d Date
+	if err := d.Scan("0000-00-00X"); err == nil || err == ErrZeroDate {
+		t.Errorf("Expected Scan(0000-00-00X) to return a parse error but got %v", err)
+	}
+}
+
+func TestValue(t *testing.T) {
+	d := MustParse(RFC3339, "2012-03-10")
+	v, err := d.Value()
+	if err != nil {
+		t.Fatal("Unexpected Value error:", err)
+	}
+	if tm, ok := v.(time.Time); !ok || !tm.Equal(time.Date(2012, 3, 10, 0, 0, 0, 0, time.UTC)) || tm.Location() != time.UTC {
+		t.Errorf("Expected Value to return 2012-03-10T00:00:00Z but got %#v", v)
+	}
+	if !driver.IsValue(v) {
+		t.Errorf("Expected Value to return a valid driver.Value but got %T", v)
+	}
+}
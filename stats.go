@@ -0,0 +1,58 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import "slices"
+
+// The functions below summarize a slice of dates, which need not be sorted.
+// Each returns ok as false, with a zero Date, for an empty slice, since no
+// date is a meaningful summary of no dates.
+
+// MinOf returns the earliest of dates.
+func MinOf(dates []Date) (min Date, ok bool) {
+	if len(dates) == 0 {
+		return 0, false
+	}
+	return slices.Min(dates), true
+}
+
+// MaxOf returns the latest of dates.
+func MaxOf(dates []Date) (max Date, ok bool) {
+	if len(dates) == 0 {
+		return 0, false
+	}
+	return slices.Max(dates), true
+}
+
+// Median returns the median of dates. For an even number of dates, it is the
+// midpoint of the middle two, rounded down to the earlier date when they are
+// an odd number of days apart. Dates is not modified.
+func Median(dates []Date) (median Date, ok bool) {
+	n := len(dates)
+	if n == 0 {
+		return 0, false
+	}
+	sorted := slices.Clone(dates)
+	slices.Sort(sorted)
+	if n%2 == 1 {
+		return sorted[n/2], true
+	}
+	lo, hi := sorted[n/2-1], sorted[n/2]
+	return lo + (hi-lo)/2, true
+}
+
+// MeanDate returns the mean of dates, rounded to the nearest date, with
+// halves rounded to the later date.
+func MeanDate(dates []Date) (mean Date, ok bool) {
+	n := uint64(len(dates))
+	if n == 0 {
+		return 0, false
+	}
+	var sum uint64
+	for _, d := range dates {
+		sum += uint64(d)
+	}
+	return Date((2*sum + n) / (2 * n)), true
+}
@@ -0,0 +1,46 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	tests := []struct {
+		dates                  []Date
+		min, max, median, mean Date
+	}{
+		{[]Date{15409}, 15409, 15409, 15409, 15409},
+		{[]Date{15409, 15400, 15430}, 15400, 15430, 15409, 15413},
+		{[]Date{15400, 15409}, 15400, 15409, 15404, 15405},
+		{[]Date{MaxDate, MaxDate - 1}, MaxDate - 1, MaxDate, MaxDate - 1, MaxDate},
+		{[]Date{0, MaxDate, 0, MaxDate}, 0, MaxDate, MaxDate / 2, MaxDate/2 + 1},
+	}
+	for _, test := range tests {
+		orig := slices.Clone(test.dates)
+		if d, ok := MinOf(test.dates); !ok || d != test.min {
+			t.Errorf("Expected MinOf(%v) to return %s but got %s", test.dates, test.min, d)
+		}
+		if d, ok := MaxOf(test.dates); !ok || d != test.max {
+			t.Errorf("Expected MaxOf(%v) to return %s but got %s", test.dates, test.max, d)
+		}
+		if d, ok := Median(test.dates); !ok || d != test.median {
+			t.Errorf("Expected Median(%v) to return %s but got %s", test.dates, test.median, d)
+		}
+		if d, ok := MeanDate(test.dates); !ok || d != test.mean {
+			t.Errorf("Expected MeanDate(%v) to return %s but got %s", test.dates, test.mean, d)
+		}
+		if !slices.Equal(test.dates, orig) {
+			t.Errorf("Expected the statistics to leave %v unmodified but got %v", orig, test.dates)
+		}
+	}
+	for name, f := range map[string]func([]Date) (Date, bool){"MinOf": MinOf, "MaxOf": MaxOf, "Median": Median, "MeanDate": MeanDate} {
+		if d, ok := f(nil); ok || d != 0 {
+			t.Errorf("Expected %s of no dates to return 0, false but got %s, %v", name, d, ok)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+// TemplateFuncs returns functions for manipulating Dates in templates. The
+// result may be passed to the Funcs method of either text/template or
+// html/template. Arguments are ordered so that the Date may be piped in as
+// the last argument:
+//
+//	format LAYOUT DATE       Format the date using the layout, as with Format
+//	addDays N DATE           The date N days later (earlier if N < 0)
+//	startOfMonth DATE        The first day of the month containing the date
+//	humanize DATE            The date relative to today, such as "in 3 days"
+//	today                    The current local date, as with Today
+//
+// For example:
+//
+//	Due {{.Due | format "Monday, January 2"}} ({{humanize .Due}})
+//
+// Since addDays fails the execution of the template if the result is not a
+// representable date, the dates produced in templates are always valid.
+func TemplateFuncs() map[string]interface{} {
+	return map[string]interface{}{
+		"format": func(layout string, d Date) string {
+			return d.Format(layout)
+		},
+		"addDays": func(n int, d Date) (Date, error) {
+			return FromDays(int(d) + n)
+		},
+		"startOfMonth": func(d Date) Date {
+			return d.Truncate(Month)
+		},
+		"humanize": func(d Date) string {
+			return d.Humanize(Today())
+		},
+		"today": Today,
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	today := Today()
+	data := map[string]Date{"Due": 15409, "Soon": today + 3}
+	tests := []struct {
+		text, want string
+	}{
+		{`{{.Due | format "Monday, January 2"}}`, "Saturday, March 10"},
+		{`{{.Due | addDays 7}}`, "2012-03-17"},
+		{`{{.Due | addDays -10 | startOfMonth}}`, "2012-02-01"},
+		{`{{humanize .Soon}}`, "in 3 days"},
+		{`{{eq today .Soon}}`, "false"},
+		{`{{today | format "2006"}}`, today.Format("2006")},
+	}
+	for _, test := range tests {
+		var text, html strings.Builder
+		if err := template.Must(template.New("").Funcs(TemplateFuncs()).Parse(test.text)).Execute(&text, data); err != nil {
+			t.Errorf("Unexpected text/template error for %s: %v", test.text, err)
+		} else if text.String() != test.want {
+			t.Errorf("Expected text/template %s to produce %q but got %q", test.text, test.want, text.String())
+		}
+		if err := htmltemplate.Must(htmltemplate.New("").Funcs(TemplateFuncs()).Parse(test.text)).Execute(&html, data); err != nil {
+			t.Errorf("Unexpected html/template error for %s: %v", test.text, err)
+		} else if html.String() != test.want {
+			t.Errorf("Expected html/template %s to produce %q but got %q", test.text, test.want, html.String())
+		}
+	}
+	tmpl := template.Must(template.New("").Funcs(TemplateFuncs()).Parse(`{{.Due | addDays -20000}}`))
+	if err := tmpl.Execute(new(strings.Builder), data); err == nil {
+		t.Error("Expected addDays beyond the representable range to fail the template")
+	}
+}
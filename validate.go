@@ -0,0 +1,90 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import "errors"
+
+// MaxReportSamples is the maximum number of samples recorded per category in
+// a Report.
+const MaxReportSamples = 5
+
+// A Report summarizes the quality of a column of date strings, as produced
+// by ValidateColumn. Every value is counted in exactly one of Empty,
+// ParseErrors, OutOfRange, or Valid; Outliers is a subset of Valid.
+type Report struct {
+	Total       int // number of values examined
+	Valid       int // values which parsed to a date within [min,max]
+	Empty       int // empty strings, typically representing missing values
+	ParseErrors int // values which could not be parsed using the layout
+	OutOfRange  int // values which parsed, but fall outside of [min,max]
+
+	// Outliers counts valid values equal to Jan 1 1970, which usually
+	// indicates a zero or null value coerced into a date upstream.
+	Outliers int
+
+	// MostCommon is the most frequently occurring valid date, and
+	// MostCommonCount the number of times it occurs. A single date
+	// accounting for a large share of Valid is often a default value.
+	MostCommon      Date
+	MostCommonCount int
+
+	// Samples of the values in each failure category, in input order, up to
+	// MaxReportSamples each.
+	ParseErrorSamples []Sample
+	OutOfRangeSamples []Sample
+	OutlierSamples    []Sample
+}
+
+// A Sample records a problematic value found by ValidateColumn.
+type Sample struct {
+	Index int    // position within the input
+	Value string // the input value
+	Err   error  // the parse or range error, if any
+}
+
+// ValidateColumn parses each of values using layout, as with Parse, and
+// reports on how many values were missing, malformed, outside of the
+// inclusive range [min,max], or suspicious.
+func ValidateColumn(values []string, layout string, min, max Date) Report {
+	var r Report
+	counts := make(map[Date]int)
+	addSample := func(samples *[]Sample, i int, err error) {
+		if len(*samples) < MaxReportSamples {
+			*samples = append(*samples, Sample{i, values[i], err})
+		}
+	}
+	for i, v := range values {
+		r.Total++
+		if v == "" {
+			r.Empty++
+			continue
+		}
+		d, err := Parse(layout, v)
+		switch {
+		case errors.Is(err, ErrOutOfRange):
+			r.OutOfRange++
+			addSample(&r.OutOfRangeSamples, i, err)
+			continue
+		case err != nil:
+			r.ParseErrors++
+			addSample(&r.ParseErrorSamples, i, err)
+			continue
+		case d < min || d > max:
+			r.OutOfRange++
+			addSample(&r.OutOfRangeSamples, i, nil)
+			continue
+		}
+		r.Valid++
+		if d == 0 {
+			r.Outliers++
+			addSample(&r.OutlierSamples, i, nil)
+		}
+		counts[d]++
+		if n := counts[d]; n > r.MostCommonCount || n == r.MostCommonCount && d < r.MostCommon {
+			r.MostCommon, r.MostCommonCount = d, n
+		}
+	}
+	return r
+}
@@ -0,0 +1,59 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import "testing"
+
+func TestValidateColumn(t *testing.T) {
+	values := []string{
+		"2012-03-10",
+		"",
+		"1970-01-01",
+		"2012-03-10",
+		"03/10/2012",
+		"1969-12-31",
+		"2000-01-01",
+		"2012-02-30",
+		"1970-01-01",
+		"2012-03-11",
+	}
+	min, _ := Parse(RFC3339, "1970-01-01")
+	max, _ := Parse(RFC3339, "2020-12-31")
+	r := ValidateColumn(values, RFC3339, min+1, max)
+	want := Report{Total: 10, Valid: 4, Empty: 1, ParseErrors: 2, OutOfRange: 3}
+	if r.Total != want.Total || r.Valid != want.Valid || r.Empty != want.Empty ||
+		r.ParseErrors != want.ParseErrors || r.OutOfRange != want.OutOfRange || r.Outliers != 0 {
+		t.Fatalf("Expected report counts %+v but got %+v", want, r)
+	}
+	if r.MostCommon.String() != "2012-03-10" || r.MostCommonCount != 2 {
+		t.Error("Expected most common date to be 2012-03-10 (2) but got", r.MostCommon, r.MostCommonCount)
+	}
+	if len(r.ParseErrorSamples) != 2 || r.ParseErrorSamples[0].Index != 4 || r.ParseErrorSamples[0].Err == nil {
+		t.Errorf("Unexpected parse error samples: %+v", r.ParseErrorSamples)
+	}
+	if len(r.OutOfRangeSamples) != 3 || r.OutOfRangeSamples[1].Value != "1969-12-31" {
+		t.Errorf("Unexpected out of range samples: %+v", r.OutOfRangeSamples)
+	}
+
+	r = ValidateColumn(values, RFC3339, min, max)
+	if r.Valid != 6 || r.Outliers != 2 || len(r.OutlierSamples) != 2 || r.OutlierSamples[1].Index != 8 {
+		t.Errorf("Expected two epoch outliers but got %d: %+v", r.Outliers, r.OutlierSamples)
+	}
+	if r.MostCommon != 0 || r.MostCommonCount != 2 {
+		t.Error("Expected ties for most common date to favor the earliest but got", r.MostCommon)
+	}
+}
+
+func TestValidateColumnSampleLimit(t *testing.T) {
+	values := make([]string, 2*MaxReportSamples)
+	for i := range values {
+		values[i] = "bogus"
+	}
+	r := ValidateColumn(values, RFC3339, 0, ^Date(0))
+	if r.ParseErrors != len(values) || len(r.ParseErrorSamples) != MaxReportSamples {
+		t.Errorf("Expected %d parse errors with %d samples but got %d with %d",
+			len(values), MaxReportSamples, r.ParseErrors, len(r.ParseErrorSamples))
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package validatordate integrates epochdate.Date and epochdate.NullDate
+// with github.com/go-playground/validator.
+//
+// Register installs the following validation tags, each of which applies to
+// Date, NullDate, and time.Time fields; dates given as parameters are in
+// RFC3339 form:
+//
+//	edate_gt=2020-01-01   after the given date
+//	edate_gte=2020-01-01  on or after the given date
+//	edate_lt=2020-01-01   before the given date
+//	edate_lte=2020-01-01  on or before the given date
+//	edate_future          after today
+//	edate_past            before today
+//
+// Today is the local date, as returned by epochdate.Today. Register also
+// presents Dates to the validator as time.Time values, so that the
+// zero Date (Jan 1 1970) satisfies "required", and invalid NullDates as nil,
+// so that they do not.
+package validatordate
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	dateType     = reflect.TypeOf(epochdate.Date(0))
+	nullDateType = reflect.TypeOf(epochdate.NullDate{})
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// Register registers the Date and NullDate types, and the edate validation
+// tags, with v.
+func Register(v *validator.Validate) error {
+	v.RegisterCustomTypeFunc(customType, epochdate.Date(0), epochdate.NullDate{})
+	validations := []struct {
+		tag string
+		fn  validator.Func
+	}{
+		{"edate_gt", compareParam(func(d, p epochdate.Date) bool { return d > p })},
+		{"edate_gte", compareParam(func(d, p epochdate.Date) bool { return d >= p })},
+		{"edate_lt", compareParam(func(d, p epochdate.Date) bool { return d < p })},
+		{"edate_lte", compareParam(func(d, p epochdate.Date) bool { return d <= p })},
+		{"edate_future", compareToday(func(d, today epochdate.Date) bool { return d > today })},
+		{"edate_past", compareToday(func(d, today epochdate.Date) bool { return d < today })},
+	}
+	for _, val := range validations {
+		if err := v.RegisterValidation(val.tag, val.fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func customType(v reflect.Value) interface{} {
+	switch d := v.Interface().(type) {
+	case epochdate.Date:
+		return d.UTC()
+	case epochdate.NullDate:
+		if d.Valid {
+			return d.Date.UTC()
+		}
+	}
+	return nil
+}
+
+// dateOf returns the date held by the field, which may have been converted
+// by customType.
+func dateOf(v reflect.Value) (epochdate.Date, bool) {
+	switch v.Type() {
+	case dateType:
+		return epochdate.Date(v.Uint()), true
+	case nullDateType:
+		n := v.Interface().(epochdate.NullDate)
+		return n.Date, n.Valid
+	case timeType:
+		d, err := epochdate.NewFromTime(v.Interface().(time.Time))
+		return d, err == nil
+	}
+	return 0, false
+}
+
+func compareParam(cmp func(d, param epochdate.Date) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		param, err := epochdate.ParseDateOnly(fl.Param())
+		if err != nil {
+			panic("validatordate: bad parameter for " + fl.GetTag() + ": " + err.Error())
+		}
+		d, ok := dateOf(fl.Field())
+		return ok && cmp(d, param)
+	}
+}
+
+func compareToday(cmp func(d, today epochdate.Date) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		d, ok := dateOf(fl.Field())
+		return ok && cmp(d, epochdate.Today())
+	}
+}
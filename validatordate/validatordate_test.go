@@ -0,0 +1,99 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package validatordate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/extemporalgenome/epochdate"
+	"github.com/go-playground/validator/v10"
+)
+
+func newValidator(t *testing.T) *validator.Validate {
+	v := validator.New()
+	if err := Register(v); err != nil {
+		t.Fatal("Unexpected Register error:", err)
+	}
+	return v
+}
+
+func TestBounds(t *testing.T) {
+	type request struct {
+		Start epochdate.Date     `validate:"edate_gte=2020-01-01,edate_lt=2021-01-01"`
+		End   epochdate.NullDate `validate:"omitempty,edate_gt=2020-01-01,edate_lte=2020-12-31"`
+		At    time.Time          `validate:"edate_gte=2020-01-01"`
+	}
+	v := newValidator(t)
+	jan1 := epochdate.MustNewFromDate(2020, time.January, 1)
+	dec31 := epochdate.MustNewFromDate(2020, time.December, 31)
+	at := jan1.UTC()
+	tests := []struct {
+		r  request
+		ok bool
+	}{
+		{request{jan1, epochdate.NullDate{}, at}, true},
+		{request{dec31, epochdate.NullDate{Date: dec31, Valid: true}, at}, true},
+		{request{jan1 - 1, epochdate.NullDate{}, at}, false},
+		{request{dec31 + 1, epochdate.NullDate{}, at}, false},
+		{request{jan1, epochdate.NullDate{Date: jan1, Valid: true}, at}, false},
+		{request{jan1, epochdate.NullDate{Date: dec31 + 1, Valid: true}, at}, false},
+		{request{jan1, epochdate.NullDate{}, at.Add(-time.Hour)}, false},
+	}
+	for _, test := range tests {
+		if err := v.Struct(test.r); (err == nil) != test.ok {
+			t.Errorf("Expected validation of %v to succeed: %v, but got %v", test.r, test.ok, err)
+		}
+	}
+}
+
+func TestRelative(t *testing.T) {
+	type request struct {
+		Due  epochdate.Date `validate:"edate_future"`
+		Born epochdate.Date `validate:"edate_past"`
+	}
+	v := newValidator(t)
+	today := epochdate.Today()
+	tests := []struct {
+		r  request
+		ok bool
+	}{
+		{request{today + 1, today - 1}, true},
+		{request{today, today - 1}, false},
+		{request{today + 1, today}, false},
+	}
+	for _, test := range tests {
+		if err := v.Struct(test.r); (err == nil) != test.ok {
+			t.Errorf("Expected validation of %v to succeed: %v, but got %v", test.r, test.ok, err)
+		}
+	}
+}
+
+func TestRequired(t *testing.T) {
+	type request struct {
+		Day   epochdate.Date     `validate:"required"`
+		Until epochdate.NullDate `validate:"required"`
+	}
+	v := newValidator(t)
+	if err := v.Struct(request{0, epochdate.NullDate{Valid: true}}); err != nil {
+		t.Error("Expected the zero Date and a valid NullDate to satisfy required but got", err)
+	}
+	if err := v.Struct(request{0, epochdate.NullDate{}}); err == nil {
+		t.Error("Expected an invalid NullDate not to satisfy required")
+	}
+}
+
+func TestBadParam(t *testing.T) {
+	type request struct {
+		Day epochdate.Date `validate:"edate_gt=yesterday"`
+	}
+	v := newValidator(t)
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a malformed parameter to panic")
+		}
+	}()
+	v.Struct(request{})
+}
@@ -0,0 +1,53 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"strings"
+	"time"
+)
+
+// WeekRules returns the conventional first day of the week, and the minimum
+// number of days that the first week of a year must contain, for the given
+// ISO 3166-1 alpha-2 region code (such as "US" or "de"). The results are
+// suitable for passing to Date.StartOfWeek and Date.Week. Unknown regions
+// receive the CLDR world default of a Monday start and a one day minimum.
+//
+// The data is derived from the CLDR supplemental weekData.
+func WeekRules(region string) (firstDay time.Weekday, minDays int) {
+	region = strings.ToUpper(region)
+	firstDay, ok := firstDays[region]
+	if !ok {
+		firstDay = time.Monday
+	}
+	minDays = 1
+	if minDays4[region] {
+		minDays = 4
+	}
+	return firstDay, minDays
+}
+
+var firstDays = make(map[string]time.Weekday)
+
+var minDays4 = make(map[string]bool)
+
+func init() {
+	for wd, regions := range map[time.Weekday]string{
+		time.Friday:   "MV",
+		time.Saturday: "AE AF BH DJ DZ EG IQ IR JO KW LY OM QA SD SY",
+		time.Sunday: "AG AS BD BR BS BT BW BZ CA CN CO DM DO ET GT GU HK HN ID " +
+			"IL IN JM JP KE KH KR LA MH MM MO MT MX MZ NI NP PA PE PH PK PR PT " +
+			"PY SA SG SV TH TT TW UM US VE VI WS YE ZA ZW",
+	} {
+		for _, r := range strings.Fields(regions) {
+			firstDays[r] = wd
+		}
+	}
+	for _, r := range strings.Fields("AD AN AT AX BE BG CH CZ DE DK EE ES FI FJ " +
+		"FO FR GB GF GG GI GP GR HU IE IM IS IT JE LI LT LU MC MQ NL NO PL RE " +
+		"RU SE SJ SK SM VA") {
+		minDays4[r] = true
+	}
+}
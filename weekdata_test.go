@@ -0,0 +1,121 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWeekRules(t *testing.T) {
+	tests := []struct {
+		region   string
+		firstDay time.Weekday
+		minDays  int
+	}{
+		{"US", time.Sunday, 1},
+		{"us", time.Sunday, 1},
+		{"DE", time.Monday, 4},
+		{"GB", time.Monday, 4},
+		{"EG", time.Saturday, 1},
+		{"MV", time.Friday, 1},
+		{"", time.Monday, 1},
+		{"ZZ", time.Monday, 1},
+	}
+	for _, test := range tests {
+		if wd, n := WeekRules(test.region); wd != test.firstDay || n != test.minDays {
+			t.Errorf("Expected WeekRules(%q) to return %s, %d but got %s, %d",
+				test.region, test.firstDay, test.minDays, wd, n)
+		}
+	}
+}
+
+func TestWeekISO(t *testing.T) {
+	for d := Date(0); ; d++ {
+		y1, w1 := d.Week(time.Monday, 4)
+		y2, w2 := d.UTC().ISOWeek()
+		if y1 != y2 || w1 != w2 {
+			t.Fatalf("Expected %s.Week(Monday, 4) to return %d, %d but got %d, %d", d, y2, w2, y1, w1)
+		}
+		if d == ^Date(0) {
+			break
+		}
+	}
+}
+
+func TestWeekUS(t *testing.T) {
+	first, minDays := WeekRules("US")
+	tests := []struct {
+		date       string
+		year, week int
+	}{
+		{"2012-01-01", 2012, 1},
+		{"2012-01-07", 2012, 1},
+		{"2012-01-08", 2012, 2},
+		{"2012-12-29", 2012, 52},
+		{"2012-12-30", 2013, 1},
+		{"2011-01-01", 2011, 1},
+		{"2011-01-02", 2011, 2},
+	}
+	for _, test := range tests {
+		d, _ := Parse(RFC3339, test.date)
+		if y, w := d.Week(first, minDays); y != test.year || w != test.week {
+			t.Errorf("Expected %s.Week(%s, %d) to return %d, %d but got %d, %d",
+				d, first, minDays, test.year, test.week, y, w)
+		}
+	}
+}
+
+func TestWorkWeek(t *testing.T) {
+	tests := []struct {
+		date     string
+		year, ww int
+	}{
+		{"2012-01-01", 2012, 1},
+		{"2012-03-10", 2012, 10},
+		{"2012-03-11", 2012, 11},
+		{"2012-12-30", 2013, 1},
+		{"2016-01-01", 2016, 1},
+		{"2016-01-03", 2016, 2},
+	}
+	for _, test := range tests {
+		d, _ := Parse(RFC3339, test.date)
+		if y, ww := d.WorkWeek(time.Sunday); y != test.year || ww != test.ww {
+			t.Errorf("Expected %s.WorkWeek(Sunday) to return %d, %d but got %d, %d", d, test.year, test.ww, y, ww)
+		}
+	}
+}
+
+func TestFromISOWeek(t *testing.T) {
+	for d := Date(0); ; d++ {
+		year, week := d.ISOWeek()
+		if rt, err := FromISOWeek(year, week, d.Weekday()); err != nil || rt != d {
+			t.Fatalf("Expected FromISOWeek(%d, %d, %s) to return %s but got %s, %v",
+				year, week, d.Weekday(), d, rt, err)
+		}
+		if d == ^Date(0) {
+			break
+		}
+	}
+	tests := []struct {
+		year, week int
+		wd         time.Weekday
+		err        error
+	}{
+		{2015, 53, time.Monday, nil},
+		{2014, 53, time.Monday, ErrInvalidDate},
+		{2014, 0, time.Monday, ErrInvalidDate},
+		{2014, 1, 7, ErrInvalidDate},
+		{1970, 1, time.Monday, ErrOutOfRange},
+		{2149, 23, time.Saturday, ErrOutOfRange},
+	}
+	for _, test := range tests {
+		if _, err := FromISOWeek(test.year, test.week, test.wd); !errors.Is(err, test.err) {
+			t.Errorf("Expected FromISOWeek(%d, %d, %s) to return %v but got %v",
+				test.year, test.week, test.wd, test.err, err)
+		}
+	}
+}
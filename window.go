@@ -0,0 +1,112 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// A Window is a preset date window defined relative to the current date, as
+// commonly offered by reporting dashboards.
+type Window int
+
+// Preset windows. The "last N days" windows are the N days ending on, and
+// including, the current date. The "to date" windows run from the start of
+// the current week, month, quarter, or year through the current date. The
+// "previous" windows are the complete calendar period preceding the current
+// one.
+const (
+	WindowToday Window = iota
+	WindowYesterday
+	WindowLast7Days
+	WindowLast30Days
+	WindowLast90Days
+	WindowWeekToDate
+	WindowMonthToDate
+	WindowQuarterToDate
+	WindowYearToDate
+	WindowPreviousWeek
+	WindowPreviousMonth
+	WindowPreviousQuarter
+	WindowPreviousYear
+)
+
+var windowNames = [...]string{
+	WindowToday:           "today",
+	WindowYesterday:       "yesterday",
+	WindowLast7Days:       "last_7_days",
+	WindowLast30Days:      "last_30_days",
+	WindowLast90Days:      "last_90_days",
+	WindowWeekToDate:      "week_to_date",
+	WindowMonthToDate:     "month_to_date",
+	WindowQuarterToDate:   "quarter_to_date",
+	WindowYearToDate:      "year_to_date",
+	WindowPreviousWeek:    "previous_week",
+	WindowPreviousMonth:   "previous_month",
+	WindowPreviousQuarter: "previous_quarter",
+	WindowPreviousYear:    "previous_year",
+}
+
+// ParseWindow returns the Window with the given name, such as "last_7_days"
+// or "month_to_date", as returned by Window.String.
+func ParseWindow(name string) (Window, error) {
+	for w, n := range windowNames {
+		if n == name {
+			return Window(w), nil
+		}
+	}
+	return 0, errors.New("epochdate: unknown window " + strconv.Quote(name))
+}
+
+// String returns the name of the window, such as "last_7_days".
+func (w Window) String() string {
+	if w >= 0 && int(w) < len(windowNames) {
+		return windowNames[w]
+	}
+	return "Window(" + strconv.Itoa(int(w)) + ")"
+}
+
+// Resolve returns the Range of dates in the window relative to the current
+// date now, with weeks beginning on the weekday first (see WeekRules). Bounds
+// which fall outside the representable range are truncated to it. Resolve
+// panics if w is not a known Window.
+func (w Window) Resolve(now Date, first time.Weekday) Range {
+	var end Date
+	switch w {
+	case WindowToday:
+		return Range{now, now}
+	case WindowYesterday:
+		return Range{now.Prev(), now.Prev()}
+	case WindowLast7Days:
+		return Range{clampDays(int(now) - 6), now}
+	case WindowLast30Days:
+		return Range{clampDays(int(now) - 29), now}
+	case WindowLast90Days:
+		return Range{clampDays(int(now) - 89), now}
+	case WindowWeekToDate:
+		return Range{now.StartOfWeek(first), now}
+	case WindowMonthToDate:
+		return Range{now.Truncate(Month), now}
+	case WindowQuarterToDate:
+		return Range{now.Truncate(Quarter), now}
+	case WindowYearToDate:
+		return Range{now.Truncate(Year), now}
+	case WindowPreviousWeek:
+		end = now.StartOfWeek(first).Prev()
+		return Range{end.StartOfWeek(first), end}
+	case WindowPreviousMonth:
+		end = now.Truncate(Month).Prev()
+		return Range{end.Truncate(Month), end}
+	case WindowPreviousQuarter:
+		end = now.Truncate(Quarter).Prev()
+		return Range{end.Truncate(Quarter), end}
+	case WindowPreviousYear:
+		end = now.Truncate(Year).Prev()
+		return Range{end.Truncate(Year), end}
+	}
+	panic("epochdate: unknown Window")
+}
@@ -0,0 +1,53 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindowResolve(t *testing.T) {
+	// 2012-05-16 is a Wednesday.
+	now := MustParse(RFC3339, "2012-05-16")
+	tests := []struct {
+		name       string
+		start, end string
+	}{
+		{"today", "2012-05-16", "2012-05-16"},
+		{"yesterday", "2012-05-15", "2012-05-15"},
+		{"last_7_days", "2012-05-10", "2012-05-16"},
+		{"last_30_days", "2012-04-17", "2012-05-16"},
+		{"last_90_days", "2012-02-17", "2012-05-16"},
+		{"week_to_date", "2012-05-13", "2012-05-16"},
+		{"month_to_date", "2012-05-01", "2012-05-16"},
+		{"quarter_to_date", "2012-04-01", "2012-05-16"},
+		{"year_to_date", "2012-01-01", "2012-05-16"},
+		{"previous_week", "2012-05-06", "2012-05-12"},
+		{"previous_month", "2012-04-01", "2012-04-30"},
+		{"previous_quarter", "2012-01-01", "2012-03-31"},
+		{"previous_year", "2011-01-01", "2011-12-31"},
+	}
+	for _, test := range tests {
+		w, err := ParseWindow(test.name)
+		if err != nil {
+			t.Errorf("Unexpected ParseWindow(%q) error: %v", test.name, err)
+			continue
+		} else if w.String() != test.name {
+			t.Errorf("Expected ParseWindow(%q).String() to round trip but got %q", test.name, w)
+		}
+		r := w.Resolve(now, time.Sunday)
+		if r.Start.String() != test.start || r.End.String() != test.end {
+			t.Errorf("Expected %s to resolve to %s through %s but got %s",
+				w, test.start, test.end, r)
+		}
+	}
+	if _, err := ParseWindow("last_week"); err == nil {
+		t.Error("Expected ParseWindow(last_week) to return an error")
+	}
+	if r := WindowLast30Days.Resolve(3, time.Monday); r != (Range{0, 3}) {
+		t.Error("Expected windows to be truncated to Jan 1 1970 but got", r)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand"
+	"reflect"
+	"time"
+)
+
+// A LayoutProvider names a layout at the type level, for use as the type
+// parameter of Formatted. Layout is called on the zero value, so providers
+// are typically empty structs.
+type LayoutProvider interface {
+	Layout() string
+}
+
+// Layout providers for the conventional layouts.
+type (
+	RFC3339Layout  struct{} // RFC3339
+	YYYYMMDDLayout struct{} // YYYYMMDD
+	USLayout       struct{} // "01/02/2006"
+	EULayout       struct{} // EuropeanSlash
+)
+
+// Layout returns RFC3339.
+func (RFC3339Layout) Layout() string { return RFC3339 }
+
+// Layout returns YYYYMMDD.
+func (YYYYMMDDLayout) Layout() string { return YYYYMMDD }
+
+// Layout returns "01/02/2006".
+func (USLayout) Layout() string { return "01/02/2006" }
+
+// Layout returns EuropeanSlash.
+func (EULayout) Layout() string { return EuropeanSlash }
+
+// Formatted is a Date which is printed, encoded, and scanned from text using
+// the layout named by L, rather than TextLayout:
+//
+//	type BillingDate struct{}
+//
+//	func (BillingDate) Layout() string { return "Jan 2 2006" }
+//
+//	var invoice struct {
+//		Due epochdate.Formatted[BillingDate] `json:"due"`
+//	}
+//
+// The layout is also used by the CSV, flag, web framework parameter, GraphQL,
+// and slog methods. InputValue is the exception: browsers require the value
+// of a date input in RFC3339 form, so that is what it produces. All other
+// methods are those of Date, including the binary encoding and
+// driver.Valuer, which produces a time.Time for DATE columns.
+type Formatted[L LayoutProvider] struct{ Date }
+
+// DateYYYYMMDD is a Date which is printed and encoded using the YYYYMMDD
+// layout ("20060102"), such as for fixed external schemas.
+type DateYYYYMMDD = Formatted[YYYYMMDDLayout]
+
+// DateUS is a Date which is printed and encoded using the layout
+// "01/02/2006", as is conventional in the United States.
+type DateUS = Formatted[USLayout]
+
+// DateEU is a Date which is printed and encoded using the EuropeanSlash
+// layout ("02/01/2006").
+type DateEU = Formatted[EULayout]
+
+// Layout returns the layout named by L.
+func (Formatted[L]) Layout() string {
+	var l L
+	return l.Layout()
+}
+
+// String returns the date formatted using the layout.
+func (d Formatted[L]) String() string { return d.Format(d.Layout()) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Formatted[L]) MarshalText() ([]byte, error) {
+	layout := d.Layout()
+	return d.AppendFormat(make([]byte, 0, len(layout)), layout), nil
+}
+
+// AppendText implements encoding.TextAppender.
+func (d Formatted[L]) AppendText(b []byte) ([]byte, error) {
+	return d.AppendFormat(b, d.Layout()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Formatted[L]) UnmarshalText(data []byte) error {
+	return d.parse(string(data))
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Formatted[L]) MarshalJSON() ([]byte, error) {
+	layout := d.Layout()
+	b := make([]byte, 0, len(layout)+2)
+	return append(d.AppendFormat(append(b, '"'), layout), '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. As for Date, null leaves d
+// unchanged.
+func (d *Formatted[L]) UnmarshalJSON(data []byte) error {
+	s, null, err := jsonString(data, reflect.TypeOf(*d))
+	if err != nil || null {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// Scan implements sql.Scanner. Text is parsed using the layout; time.Time
+// values are handled as by Date.Scan.
+func (d *Formatted[L]) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	case time.Time:
+		return d.Date.Scan(v)
+	}
+	return d.Date.Scan(src)
+}
+
+// parse sets d from s using the layout.
+func (d *Formatted[L]) parse(s string) error {
+	v, err := Parse(d.Layout(), s)
+	if err != nil {
+		return err
+	}
+	d.Date = v
+	return nil
+}
+
+// MarshalCSV returns the date formatted using the layout.
+func (d Formatted[L]) MarshalCSV() (string, error) { return d.String(), nil }
+
+// UnmarshalCSV sets d from a CSV field formatted using the layout.
+func (d *Formatted[L]) UnmarshalCSV(s string) error { return d.parse(s) }
+
+// UnmarshalParam sets d from a web framework parameter formatted using the
+// layout.
+func (d *Formatted[L]) UnmarshalParam(param string) error { return d.parse(param) }
+
+// Set implements flag.Value, accepting the layout, or "today" for the
+// current local date, as for Date.Set.
+func (d *Formatted[L]) Set(s string) error {
+	if s == "today" {
+		d.Date = Today()
+		return nil
+	}
+	return d.parse(s)
+}
+
+// MarshalGQL writes d to w as a GraphQL string formatted using the layout.
+func (d Formatted[L]) MarshalGQL(w io.Writer) {
+	b, _ := d.MarshalJSON()
+	w.Write(b)
+}
+
+// UnmarshalGQL sets d from a GraphQL string formatted using the layout.
+func (d *Formatted[L]) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("epochdate: GraphQL Date must be a string, not %T", v)
+	}
+	return d.parse(s)
+}
+
+// InputValue returns d in RFC3339 form, as required for the value attribute
+// of a date input, irrespective of the layout.
+func (d Formatted[L]) InputValue() string { return d.Date.String() }
+
+// LogValue implements slog.LogValuer, recording d as a string attribute
+// formatted using the layout.
+func (d Formatted[L]) LogValue() slog.Value { return slog.StringValue(d.String()) }
+
+// Generate implements the Generator interface of testing/quick, producing
+// dates as by Date.Generate.
+func (Formatted[L]) Generate(rand *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Formatted[L]{generate(rand)})
+}
@@ -0,0 +1,162 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+func TestWrappers(t *testing.T) {
+	const d = Date(15409) // 2012-03-10
+	tests := []struct {
+		v    interface{}
+		ptr  interface{}
+		text string
+	}{
+		{DateYYYYMMDD{d}, new(DateYYYYMMDD), "20120310"},
+		{DateUS{d}, new(DateUS), "03/10/2012"},
+		{DateEU{d}, new(DateEU), "10/03/2012"},
+	}
+	for _, test := range tests {
+		if s := fmt.Sprint(test.v); s != test.text {
+			t.Errorf("Expected %T to print as %s but got %s", test.v, test.text, s)
+		}
+		b, err := test.v.(encoding.TextMarshaler).MarshalText()
+		if err != nil || string(b) != test.text {
+			t.Errorf("Expected %T.MarshalText() to return %s but got %s, %v", test.v, test.text, b, err)
+		}
+		b, err = test.v.(encoding.TextAppender).AppendText(nil)
+		if err != nil || string(b) != test.text {
+			t.Errorf("Expected %T.AppendText() to return %s but got %s, %v", test.v, test.text, b, err)
+		}
+		if err := test.ptr.(encoding.TextUnmarshaler).UnmarshalText(b); err != nil {
+			t.Errorf("Unexpected %T.UnmarshalText error: %v", test.ptr, err)
+		}
+		b, err = json.Marshal(test.v)
+		if err != nil || string(b) != `"`+test.text+`"` {
+			t.Errorf("Expected JSON encoding of %T to be %q but got %s, %v", test.v, test.text, b, err)
+		}
+		if err := json.Unmarshal(b, test.ptr); err != nil {
+			t.Errorf("Unexpected JSON decoding error for %T: %v", test.ptr, err)
+		}
+		if got := fmt.Sprint(test.ptr); got != test.text {
+			t.Errorf("Expected JSON round trip of %T to return %s but got %s", test.v, test.text, got)
+		}
+		if err := json.Unmarshal([]byte(`"2012-03-10"`), test.ptr); err == nil {
+			t.Errorf("Expected %T to reject the RFC3339 form", test.ptr)
+		}
+		var terr *json.UnmarshalTypeError
+		if err := json.Unmarshal([]byte(`15409`), test.ptr); !errors.As(err, &terr) {
+			t.Errorf("Expected %T to reject a number with a *json.UnmarshalTypeError but got %v", test.ptr, err)
+		}
+	}
+	// The remaining methods are those of Date.
+	if y, _ := (DateUS{d}).ISOWeek(); y != 2012 {
+		t.Errorf("Expected DateUS.ISOWeek() to return 2012 but got %d", y)
+	}
+}
+
+type invoiceLayout struct{}
+
+func (invoiceLayout) Layout() string { return "Jan 2 2006" }
+
+func TestFormatted(t *testing.T) {
+	var v struct {
+		Due Formatted[invoiceLayout] `json:"due"`
+	}
+	if err := json.Unmarshal([]byte(`{"due":"Mar 10 2012"}`), &v); err != nil || v.Due.Date != 15409 {
+		t.Errorf("Expected json.Unmarshal to return 2012-03-10 but got %s, %v", v.Due.Date, err)
+	}
+	if b, err := json.Marshal(v); err != nil || string(b) != `{"due":"Mar 10 2012"}` {
+		t.Errorf("Expected json.Marshal to return the custom layout but got %s, %v", b, err)
+	}
+	var rfc Formatted[RFC3339Layout]
+	rfc.Date = 15409
+	if s := rfc.String(); s != "2012-03-10" {
+		t.Errorf("Expected Formatted[RFC3339Layout] to print as 2012-03-10 but got %s", s)
+	}
+	var us DateUS
+	for _, src := range []interface{}{"03/10/2012", []byte("03/10/2012"), time.Date(2012, 3, 10, 0, 0, 0, 0, time.UTC)} {
+		us.Date = 0
+		if err := us.Scan(src); err != nil || us.Date != 15409 {
+			t.Errorf("Expected DateUS.Scan(%#v) to return 2012-03-10 but got %s, %v", src, us.Date, err)
+		}
+	}
+	if err := us.Scan("2012-03-10"); err == nil {
+		t.Error("Expected DateUS.Scan to reject text in another layout")
+	}
+	if err := us.Scan(nil); err == nil {
+		t.Error("Expected DateUS.Scan to reject NULL")
+	}
+	if v, err := us.Value(); err != nil || !v.(time.Time).Equal(us.UTC()) {
+		t.Errorf("Expected DateUS.Value() to return %v but got %v, %v", us.UTC(), v, err)
+	}
+}
+
+func TestFormattedLayoutMethods(t *testing.T) {
+	d := DateUS{15409}
+	if s, err := d.MarshalCSV(); err != nil || s != "03/10/2012" {
+		t.Errorf("Expected MarshalCSV to return 03/10/2012 but got %s, %v", s, err)
+	}
+	var gql strings.Builder
+	d.MarshalGQL(&gql)
+	if gql.String() != `"03/10/2012"` {
+		t.Errorf("Expected MarshalGQL to write \"03/10/2012\" but got %s", gql.String())
+	}
+	if v := d.LogValue(); v.String() != "03/10/2012" {
+		t.Errorf("Expected LogValue to return 03/10/2012 but got %s", v)
+	}
+	if s := d.InputValue(); s != "2012-03-10" {
+		t.Errorf("Expected InputValue to return the RFC3339 wire format but got %s", s)
+	}
+	decoders := map[string]func(*DateUS, string) error{
+		"UnmarshalCSV":   (*DateUS).UnmarshalCSV,
+		"UnmarshalParam": (*DateUS).UnmarshalParam,
+		"Set":            (*DateUS).Set,
+		"UnmarshalGQL":   func(d *DateUS, s string) error { return d.UnmarshalGQL(s) },
+	}
+	for name, decode := range decoders {
+		var v DateUS
+		if err := decode(&v, "03/10/2012"); err != nil || v.Date != 15409 {
+			t.Errorf("Expected %s(03/10/2012) to return 2012-03-10 but got %s, %v", name, v.Date, err)
+		}
+		if err := decode(&v, "2012-03-10"); err == nil {
+			t.Errorf("Expected %s to reject text in another layout", name)
+		}
+	}
+	var v DateUS
+	if err := v.UnmarshalGQL(15409); err == nil {
+		t.Error("Expected UnmarshalGQL to reject a number")
+	}
+	defer func(now func() time.Time) { Now = now }(Now)
+	Now = func() time.Time { return time.Date(2012, 3, 10, 12, 0, 0, 0, time.Local) }
+	if err := v.Set("today"); err != nil || v.Date != 15409 {
+		t.Errorf("Expected Set(today) to return 2012-03-10 but got %s, %v", v.Date, err)
+	}
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Var(&v, "due", "")
+	if err := fs.Parse([]string{"-due", "12/31/2012"}); err != nil || v.Date != 15705 {
+		t.Errorf("Expected a DateUS flag to parse 12/31/2012 but got %s, %v", v.Date, err)
+	}
+}
+
+func TestFormattedGenerate(t *testing.T) {
+	roundTrip := func(us DateUS, eu DateEU, compact DateYYYYMMDD) bool {
+		var v DateUS
+		return v.UnmarshalText([]byte(us.String())) == nil && v == us &&
+			eu.String() == eu.Format(EuropeanSlash) && compact.String() == compact.Format(YYYYMMDD)
+	}
+	if err := quick.Check(roundTrip, &quick.Config{MaxCount: 100}); err != nil {
+		t.Error(err)
+	}
+}
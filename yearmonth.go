@@ -0,0 +1,66 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"strconv"
+	"time"
+)
+
+// A YearMonth identifies a calendar month, such as March 2012. It allows
+// dates to be constructed in terms which mirror the business rules they
+// implement, for example On(time.Thursday, YearMonth{2012, time.November}, 4),
+// without the argument-order mistakes that bare integer triples invite.
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+// YearMonth returns the calendar month in which d falls.
+func (d Date) YearMonth() YearMonth {
+	year, month, _ := d.Date()
+	return YearMonth{year, month}
+}
+
+// String returns the month in the form "2006-01".
+func (ym YearMonth) String() string {
+	s := strconv.Itoa(int(ym.Month))
+	if len(s) < 2 {
+		s = "0" + s
+	}
+	return strconv.Itoa(ym.Year) + "-" + s
+}
+
+// Day returns the date of the given day of the month. ErrInvalidDate is
+// returned if the month has no such day.
+func (ym YearMonth) Day(day int) (Date, error) {
+	if day < 1 || day > DaysInMonth(ym.Year, ym.Month) {
+		return 0, ErrInvalidDate
+	}
+	return NewFromDate(ym.Year, ym.Month, day)
+}
+
+// First returns the date of the first day of the month.
+func (ym YearMonth) First() (Date, error) {
+	return ym.Day(1)
+}
+
+// Last returns the date of the last day of the month.
+func (ym YearMonth) Last() (Date, error) {
+	return ym.Day(DaysInMonth(ym.Year, ym.Month))
+}
+
+// On returns the date of the nth occurrence of the weekday wd in the month
+// of, as with NthWeekdayOfMonth; negative values of nth count from the end
+// of the month.
+func On(wd time.Weekday, of YearMonth, nth int) (Date, error) {
+	return NthWeekdayOfMonth(of.Year, of.Month, wd, nth)
+}
+
+// LastOn returns the date of the last occurrence of the weekday wd in the
+// month of.
+func LastOn(wd time.Weekday, of YearMonth) (Date, error) {
+	return NthWeekdayOfMonth(of.Year, of.Month, wd, -1)
+}
@@ -0,0 +1,43 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestYearMonth(t *testing.T) {
+	ym := YearMonth{2012, time.February}
+	if s := ym.String(); s != "2012-02" {
+		t.Error("Expected YearMonth{2012, February}.String() to return 2012-02 but got", s)
+	}
+	if got := MustParse(RFC3339, "2012-02-17").YearMonth(); got != ym {
+		t.Error("Expected 2012-02-17.YearMonth() to return", ym, "but got", got)
+	}
+	tests := []struct {
+		fn   func() (Date, error)
+		want string
+	}{
+		{func() (Date, error) { return ym.Day(29) }, "2012-02-29"},
+		{func() (Date, error) { return ym.Day(30) }, ""},
+		{func() (Date, error) { return ym.Day(0) }, ""},
+		{ym.First, "2012-02-01"},
+		{ym.Last, "2012-02-29"},
+		{func() (Date, error) { return On(time.Thursday, YearMonth{2012, time.November}, 4) }, "2012-11-22"},
+		{func() (Date, error) { return On(time.Monday, ym, 5) }, ""},
+		{func() (Date, error) { return LastOn(time.Friday, ym) }, "2012-02-24"},
+	}
+	for i, test := range tests {
+		d, err := test.fn()
+		if test.want == "" {
+			if err != ErrInvalidDate {
+				t.Errorf("Expected case %d to return ErrInvalidDate but got %s, %v", i, d, err)
+			}
+		} else if err != nil || d.String() != test.want {
+			t.Errorf("Expected case %d to return %s but got %s, %v", i, test.want, d, err)
+		}
+	}
+}